@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/filecoin-project/venus/app/submodule/actorevent"
+	"github.com/filecoin-project/venus/app/submodule/admin"
 	"github.com/filecoin-project/venus/app/submodule/blockstore"
 	"github.com/filecoin-project/venus/app/submodule/chain"
 	"github.com/filecoin-project/venus/app/submodule/common"
@@ -134,15 +135,32 @@ func (b *Builder) build(ctx context.Context) (*Node, error) {
 		return nil, errors.Wrap(err, "failed to build node.Syncer")
 	}
 
+	var client *jwtclient.AuthClient
+	cfg := nd.repo.Config()
+	if len(cfg.API.VenusAuthURL) > 0 {
+		client, err = jwtclient.NewAuthClient(cfg.API.VenusAuthURL, cfg.API.VenusAuthToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create remote jwt auth client: %w", err)
+		}
+		nd.remoteAuth = jwtclient.WarpIJwtAuthClient(client)
+	}
+
 	nd.wallet, err = wallet.NewWalletSubmodule(ctx, b.repo, nd.configModule, nd.chain, b.walletPassword)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to build node.wallet")
 	}
+	if cfg.API.RestrictSigners {
+		if client == nil {
+			return nil, errors.New("restricting signers requires the auth service, venusAuthURL is empty")
+		}
+		nd.wallet.RestrictSigners(client)
+	}
 
 	nd.mpool, err = mpool.NewMpoolSubmodule(ctx, (*builder)(b), nd.network, nd.chain, nd.wallet)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to build node.mpool")
 	}
+	nd.wallet.SetPendingFunds(nd.mpool.MPool)
 
 	nd.storageNetworking, err = storagenetworking.NewStorgeNetworkingSubmodule(ctx, nd.network)
 	if err != nil {
@@ -176,6 +194,8 @@ func (b *Builder) build(ctx context.Context) (*Node, error) {
 		return nil, err
 	}
 
+	nd.admin = admin.NewAdminSubmodule(nd.chain, nd.blockstore)
+
 	apiBuilder := NewBuilder()
 	apiBuilder.NameSpace("Filecoin")
 
@@ -194,22 +214,13 @@ func (b *Builder) build(ctx context.Context) (*Node, error) {
 		nd.common,
 		nd.eth,
 		nd.actorEvent,
+		nd.admin,
 	)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "add service failed ")
 	}
 
-	var client *jwtclient.AuthClient
-	cfg := nd.repo.Config()
-	if len(cfg.API.VenusAuthURL) > 0 {
-		client, err = jwtclient.NewAuthClient(cfg.API.VenusAuthURL, cfg.API.VenusAuthToken)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create remote jwt auth client: %w", err)
-		}
-		nd.remoteAuth = jwtclient.WarpIJwtAuthClient(client)
-	}
-
 	var ratelimiter *ratelimit.RateLimiter
 	if client != nil && cfg.RateLimitCfg.Enable {
 		if ratelimiter, err = ratelimit.NewRateLimitHandler(cfg.RateLimitCfg.Endpoint,
@@ -20,7 +20,7 @@ func OptionsFromRepo(r repo.Repo) ([]BuilderOpt, error) {
 	cfgopts := []BuilderOpt{
 		// Libp2pOptions can only be called once, so add all options here.
 		Libp2pOptions(
-			libp2p.ListenAddrStrings(cfg.Swarm.Address),
+			libp2p.ListenAddrStrings(append([]string{cfg.Swarm.Address}, cfg.Swarm.ListenAddresses...)...),
 			libp2p.Identity(sk),
 		),
 	}
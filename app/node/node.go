@@ -6,12 +6,14 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sync"
 	"syscall"
 
 	"github.com/awnumar/memguard"
 	"github.com/etherlabsio/healthcheck/v2"
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/venus/app/submodule/actorevent"
+	"github.com/filecoin-project/venus/app/submodule/admin"
 	"github.com/filecoin-project/venus/app/submodule/blockstore"
 	chain2 "github.com/filecoin-project/venus/app/submodule/chain"
 	"github.com/filecoin-project/venus/app/submodule/common"
@@ -103,6 +105,7 @@ type Node struct {
 
 	eth        *eth.EthSubModule
 	actorEvent *actorevent.ActorEventSubModule
+	admin      *admin.AdminSubmodule
 
 	//
 	// Jsonrpc
@@ -188,6 +191,8 @@ func (node *Node) Start(ctx context.Context) error {
 		return err
 	}
 
+	node.mining.Start(syncCtx)
+
 	err = node.paychan.Start(ctx)
 	if err != nil {
 		return err
@@ -199,15 +204,26 @@ func (node *Node) Start(ctx context.Context) error {
 		return err
 	}
 
+	if node.network.Pubsub != nil && node.repo.Config().PubsubConfig.DrandGossip {
+		node.chain.StartDrandGossip(syncCtx, node.network.Pubsub)
+	}
+
 	if err := node.eth.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start eth module %v", err)
 	}
 
+	if err := node.actorEvent.Start(syncCtx); err != nil {
+		return fmt.Errorf("failed to start actor event module %v", err)
+	}
+
 	return nil
 }
 
 // Stop initiates the shutdown of the node.
 func (node *Node) Stop(ctx context.Context) {
+	// stop posting to webhooks
+	node.actorEvent.Stop()
+
 	// stop eth submodule
 	log.Infof("closing eth ...")
 	if err := node.eth.Close(ctx); err != nil {
@@ -218,6 +234,10 @@ func (node *Node) Stop(ctx context.Context) {
 	log.Infof("shutting down mpool...")
 	node.mpool.Stop(ctx)
 
+	// stop mining submodule
+	log.Infof("shutting down mining...")
+	node.mining.Stop()
+
 	// stop syncer submodule
 	log.Infof("shutting down chain syncer...")
 	node.syncer.Stop(ctx)
@@ -321,20 +341,33 @@ func (node *Node) RunRPCAndWait(ctx context.Context, rootCmdDaemon *cmds.Command
 
 	terminate := make(chan error, 1)
 
+	var shutdownOnce sync.Once
+	shutdown := func() {
+		shutdownOnce.Do(func() {
+			log.Infof("shutting down server...")
+			if err := apiServ.Shutdown(ctx); err != nil {
+				log.Warnf("failed to shutdown server: %v", err)
+			}
+			apiStatusGauge.Set(ctx, 0)
+			node.Stop(ctx)
+			memguard.Purge()
+			log.Infof("venus shutdown gracefully ...")
+			terminate <- nil
+		})
+	}
+
 	// todo: design an genterfull
 	memguard.CatchSignal(func(signal os.Signal) {
 		log.Infof("received signal(%s), venus will shutdown...", signal.String())
-		log.Infof("shutting down server...")
-		if err := apiServ.Shutdown(ctx); err != nil {
-			log.Warnf("failed to shutdown server: %v", err)
-		}
-		apiStatusGauge.Set(ctx, 0)
-		node.Stop(ctx)
-		memguard.Purge()
-		log.Infof("venus shutdown gracefully ...")
-		terminate <- nil
+		shutdown()
 	}, syscall.SIGTERM, os.Interrupt)
 
+	go func() {
+		<-node.admin.ShutdownRequested()
+		log.Infof("shutdown requested through the admin api, venus will shutdown...")
+		shutdown()
+	}()
+
 	close(ready)
 	return <-terminate
 }
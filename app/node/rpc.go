@@ -6,6 +6,7 @@ import (
 
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/venus/app/submodule/actorevent"
+	"github.com/filecoin-project/venus/app/submodule/admin"
 	"github.com/filecoin-project/venus/app/submodule/eth"
 	v0api "github.com/filecoin-project/venus/venus-shared/api/chain/v0"
 	v1api "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
@@ -42,6 +43,7 @@ func (builder *RPCBuilder) AddServices(services ...RPCService) error {
 
 var ethSubModuleTyp = reflect.TypeOf(&eth.EthSubModule{}).Elem()
 var actorEventSubModuleTyp = reflect.TypeOf(&actorevent.ActorEventSubModule{}).Elem()
+var adminSubmoduleTyp = reflect.TypeOf(&admin.AdminSubmodule{}).Elem()
 
 func skipV0API(in interface{}) bool {
 	inT := reflect.TypeOf(in)
@@ -49,7 +51,7 @@ func skipV0API(in interface{}) bool {
 		inT = inT.Elem()
 	}
 
-	return inT.AssignableTo(ethSubModuleTyp) || inT.AssignableTo(actorEventSubModuleTyp)
+	return inT.AssignableTo(ethSubModuleTyp) || inT.AssignableTo(actorEventSubModuleTyp) || inT.AssignableTo(adminSubmoduleTyp)
 }
 
 func (builder *RPCBuilder) AddV0API(service RPCService) error {
@@ -16,6 +16,7 @@ type ActorEventSubModule struct { // nolint
 	ethModule         *eth.EthSubModule
 	cfg               *config.Config
 	actorEventHandler v1api.IActorEvent
+	webhooks          *webhookSink
 }
 
 func NewActorEventSubModule(ctx context.Context,
@@ -31,7 +32,7 @@ func NewActorEventSubModule(ctx context.Context,
 	}
 
 	if !cfg.EventsConfig.EnableActorEventsAPI {
-		return aem, nil
+		return aem, aem.setupWebhooks()
 	}
 
 	fm := ethModule.GetEventFilterManager()
@@ -51,7 +52,23 @@ func NewActorEventSubModule(ctx context.Context,
 		abi.ChainEpoch(cfg.FevmConfig.Event.MaxFilterHeightRange),
 	)
 
-	return aem, nil
+	return aem, aem.setupWebhooks()
+}
+
+func (aem *ActorEventSubModule) setupWebhooks() error {
+	var err error
+	aem.webhooks, err = newWebhookSink(aem.chainModule.ChainReader, aem.chainModule.MessageStore,
+		aem.actorEventHandler, aem.cfg.EventsConfig.Webhooks)
+	return err
+}
+
+// Start starts posting chain events to the configured webhooks.
+func (aem *ActorEventSubModule) Start(ctx context.Context) error {
+	return aem.webhooks.start(ctx)
+}
+
+func (aem *ActorEventSubModule) Stop() {
+	aem.webhooks.stop()
 }
 
 func (aem *ActorEventSubModule) API() v1api.IActorEvent {
@@ -0,0 +1,266 @@
+package actorevent
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	"github.com/filecoin-project/venus/pkg/config"
+	v1api "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+const (
+	webhookTimeout   = 10 * time.Second
+	webhookBackoff   = time.Second
+	webhookQueueSize = 256
+
+	// WebhookSignatureHeader holds the hex encoded HMAC-SHA256 of the body, keyed with the secret
+	// of the webhook.
+	WebhookSignatureHeader = "X-Venus-Signature"
+)
+
+const (
+	webhookApply      = "apply"
+	webhookRevert     = "revert"
+	webhookMessage    = "message"
+	webhookActorEvent = "actor_event"
+)
+
+// webhookPayload is the body posted to a webhook, Kind tells which of Message and Event is set.
+type webhookPayload struct {
+	Kind       string            `json:"kind"`
+	Height     abi.ChainEpoch    `json:"height"`
+	TipSet     types.TipSetKey   `json:"tipset"`
+	MessageCid *cid.Cid          `json:"messageCid,omitempty"`
+	Message    *types.Message    `json:"message,omitempty"`
+	Event      *types.ActorEvent `json:"event,omitempty"`
+}
+
+type webhook struct {
+	cfg      config.WebhookConfig
+	client   *http.Client
+	queue    chan webhookPayload
+	messages map[address.Address]struct{}
+}
+
+func (w *webhook) enqueue(p webhookPayload) {
+	select {
+	case w.queue <- p:
+	default:
+		log.Warnf("webhook %s is falling behind, dropping %s at %d", w.cfg.URL, p.Kind, p.Height)
+	}
+}
+
+func (w *webhook) deliver(ctx context.Context) {
+	for {
+		select {
+		case p := <-w.queue:
+			body, err := json.Marshal(p)
+			if err != nil {
+				log.Errorf("marshaling webhook payload: %s", err)
+				continue
+			}
+			w.postWithRetries(ctx, p, body)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *webhook) postWithRetries(ctx context.Context, p webhookPayload, body []byte) {
+	backoff := webhookBackoff
+	for attempt := 0; ; attempt++ {
+		err := w.post(ctx, body)
+		if err == nil {
+			return
+		}
+		if attempt >= w.cfg.MaxRetries {
+			log.Errorf("dropping %s at %d for webhook %s after %d attempts: %s", p.Kind, p.Height, w.cfg.URL, attempt+1, err)
+			return
+		}
+		log.Warnf("posting %s at %d to webhook %s, retrying in %s: %s", p.Kind, p.Height, w.cfg.URL, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		backoff *= 2
+	}
+}
+
+func (w *webhook) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.cfg.Secret) > 0 {
+		mac := hmac.New(sha256.New, []byte(w.cfg.Secret))
+		mac.Write(body)
+		req.Header.Set(WebhookSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// webhookSink posts the head changes, message inclusions and actor events each configured
+// webhook subscribes to. Every webhook has its own queue, so a slow one doesn't hold the others.
+type webhookSink struct {
+	store    *chain.Store
+	msgStore *chain.MessageStore
+	events   v1api.IActorEvent
+	hooks    []*webhook
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newWebhookSink(store *chain.Store, msgStore *chain.MessageStore, events v1api.IActorEvent, cfgs []config.WebhookConfig) (*webhookSink, error) {
+	ws := &webhookSink{store: store, msgStore: msgStore, events: events}
+	for _, cfg := range cfgs {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return nil, fmt.Errorf("invalid webhook url %s: %w", cfg.URL, err)
+		}
+		w := &webhook{
+			cfg:      cfg,
+			client:   &http.Client{Timeout: webhookTimeout},
+			queue:    make(chan webhookPayload, webhookQueueSize),
+			messages: make(map[address.Address]struct{}, len(cfg.Messages)),
+		}
+		for _, addr := range cfg.Messages {
+			w.messages[addr] = struct{}{}
+		}
+		ws.hooks = append(ws.hooks, w)
+	}
+	return ws, nil
+}
+
+func (ws *webhookSink) start(ctx context.Context) error {
+	if len(ws.hooks) == 0 {
+		return nil
+	}
+	ctx, ws.cancel = context.WithCancel(ctx)
+
+	watchHead := false
+	for _, w := range ws.hooks {
+		if w.cfg.ActorEvents {
+			ch, err := ws.events.SubscribeActorEventsRaw(ctx, &types.ActorEventFilter{Addresses: w.cfg.EventAddresses})
+			if err != nil {
+				ws.stop()
+				return fmt.Errorf("subscribing actor events for webhook %s: %w", w.cfg.URL, err)
+			}
+			ws.wg.Add(1)
+			go ws.forwardEvents(w, ch)
+		}
+		watchHead = watchHead || w.cfg.HeadChanges || len(w.messages) > 0
+
+		ws.wg.Add(1)
+		go func(w *webhook) {
+			defer ws.wg.Done()
+			w.deliver(ctx)
+		}(w)
+	}
+
+	if watchHead {
+		ws.wg.Add(1)
+		go ws.headLoop(ctx)
+	}
+	return nil
+}
+
+func (ws *webhookSink) stop() {
+	if ws.cancel == nil {
+		return
+	}
+	ws.cancel()
+	ws.wg.Wait()
+}
+
+func (ws *webhookSink) forwardEvents(w *webhook, ch <-chan *types.ActorEvent) {
+	defer ws.wg.Done()
+	for ev := range ch {
+		w.enqueue(webhookPayload{Kind: webhookActorEvent, Height: ev.Height, TipSet: ev.TipSetKey, Event: ev})
+	}
+}
+
+func (ws *webhookSink) headLoop(ctx context.Context) {
+	defer ws.wg.Done()
+
+	changes := ws.store.SubHeadChanges(ctx)
+	for {
+		select {
+		case notif, ok := <-changes:
+			if !ok {
+				return
+			}
+			for _, change := range notif {
+				switch change.Type {
+				case types.HCApply:
+					ws.headChanged(webhookApply, change.Val)
+					ws.messagesIncluded(change.Val)
+				case types.HCRevert:
+					ws.headChanged(webhookRevert, change.Val)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (ws *webhookSink) headChanged(kind string, ts *types.TipSet) {
+	for _, w := range ws.hooks {
+		if w.cfg.HeadChanges {
+			w.enqueue(webhookPayload{Kind: kind, Height: ts.Height(), TipSet: ts.Key()})
+		}
+	}
+}
+
+func (ws *webhookSink) messagesIncluded(ts *types.TipSet) {
+	var msgs []types.ChainMsg
+	loaded := false
+	for _, w := range ws.hooks {
+		if len(w.messages) == 0 {
+			continue
+		}
+		if !loaded {
+			var err error
+			if msgs, err = ws.msgStore.MessagesForTipset(ts); err != nil {
+				log.Warnf("loading messages of %s for webhooks: %s", ts.Key(), err)
+				return
+			}
+			loaded = true
+		}
+		for _, cm := range msgs {
+			msg := cm.VMMessage()
+			_, from := w.messages[msg.From]
+			_, to := w.messages[msg.To]
+			if from || to {
+				c := cm.Cid()
+				w.enqueue(webhookPayload{Kind: webhookMessage, Height: ts.Height(), TipSet: ts.Key(), MessageCid: &c, Message: msg})
+			}
+		}
+	}
+}
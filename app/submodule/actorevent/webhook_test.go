@@ -0,0 +1,58 @@
+package actorevent
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/config"
+)
+
+func TestWebhookPostWithRetries(t *testing.T) {
+	secret := "s3cret"
+	var calls int
+	var got webhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), r.Header.Get(WebhookSignatureHeader))
+		require.NoError(t, json.Unmarshal(body, &got))
+	}))
+	defer srv.Close()
+
+	ws, err := newWebhookSink(nil, nil, nil, []config.WebhookConfig{{URL: srv.URL, Secret: secret, MaxRetries: 1}})
+	require.NoError(t, err)
+	w := ws.hooks[0]
+
+	p := webhookPayload{Kind: webhookApply, Height: 10}
+	body, err := json.Marshal(p)
+	require.NoError(t, err)
+	w.postWithRetries(context.Background(), p, body)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, webhookApply, got.Kind)
+
+	// no retries left, the failure drops the payload
+	calls = 0
+	w.cfg.MaxRetries = 0
+	w.postWithRetries(context.Background(), p, body)
+	assert.Equal(t, 1, calls)
+
+	_, err = newWebhookSink(nil, nil, nil, []config.WebhookConfig{{URL: "not a url"}})
+	assert.Error(t, err)
+}
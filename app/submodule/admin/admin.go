@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ipfs-force-community/sophon-auth/core"
+	"github.com/ipfs/go-cid"
+	logging "github.com/ipfs/go-log/v2"
+
+	"github.com/filecoin-project/venus/app/submodule/blockstore"
+	"github.com/filecoin-project/venus/app/submodule/chain"
+	v1api "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+var auditLog = logging.Logger("admin-audit")
+
+// ErrNotConfirmed is returned by the admin operations called without confirm.
+var ErrNotConfirmed = errors.New("admin operation not confirmed, call it again with confirm set")
+
+var _ v1api.IAdmin = (*adminAPI)(nil)
+
+// AdminSubmodule serves the destructive and maintenance operations of the node.
+type AdminSubmodule struct { //nolint
+	chain      *chain.ChainSubmodule
+	blockstore *blockstore.BlockstoreSubmodule
+
+	shutdownOnce sync.Once
+	shutdown     chan struct{}
+}
+
+// NewAdminSubmodule creates the admin submodule.
+func NewAdminSubmodule(chain *chain.ChainSubmodule, blockstore *blockstore.BlockstoreSubmodule) *AdminSubmodule {
+	return &AdminSubmodule{
+		chain:      chain,
+		blockstore: blockstore,
+		shutdown:   make(chan struct{}),
+	}
+}
+
+// ShutdownRequested is closed when AdminShutdown is called.
+func (am *AdminSubmodule) ShutdownRequested() <-chan struct{} {
+	return am.shutdown
+}
+
+// API admin module api implement
+func (am *AdminSubmodule) API() v1api.IAdmin {
+	return &adminAPI{am: am}
+}
+
+type adminAPI struct {
+	am *AdminSubmodule
+}
+
+// audited runs op when the call is confirmed, and logs the call with its outcome either way.
+func audited(ctx context.Context, method string, confirm bool, op func() error, args ...interface{}) error {
+	user, _ := core.CtxGetName(ctx)
+	fields := append([]interface{}{"method", method, "user", user}, args...)
+	if !confirm {
+		auditLog.Warnw("refused unconfirmed admin call", fields...)
+		return ErrNotConfirmed
+	}
+
+	auditLog.Infow("admin call", fields...)
+	if err := op(); err != nil {
+		auditLog.Errorw("admin call failed", append(fields, "err", err)...)
+		return err
+	}
+	auditLog.Infow("admin call done", fields...)
+	return nil
+}
+
+func (a *adminAPI) AdminSetHead(ctx context.Context, key types.TipSetKey, confirm bool) error {
+	return audited(ctx, "AdminSetHead", confirm, func() error {
+		store := a.am.chain.ChainReader
+		ts, err := store.GetTipSet(ctx, key)
+		if err != nil {
+			return err
+		}
+		return store.SetHead(ctx, ts)
+	}, "tipset", key.String())
+}
+
+func (a *adminAPI) AdminCheckpoint(ctx context.Context, key types.TipSetKey, confirm bool) error {
+	return audited(ctx, "AdminCheckpoint", confirm, func() error {
+		store := a.am.chain.ChainReader
+		ts, err := store.GetTipSet(ctx, key)
+		if err != nil {
+			return err
+		}
+		// the forks are refused when walking back the head reaches the checkpoint, so it must be
+		// in the chain of the head
+		head := store.GetHead()
+		if ts.Height() > head.Height() {
+			return fmt.Errorf("checkpoint %s is above the head %d", key, head.Height())
+		}
+		ancestor, err := store.GetTipSetByHeight(ctx, head, ts.Height(), true)
+		if err != nil {
+			return err
+		}
+		if !ancestor.Equals(ts) {
+			return fmt.Errorf("checkpoint %s is not in the chain of the head, set the head to it first", key)
+		}
+		if err := store.WriteCheckPoint(ctx, key); err != nil {
+			return err
+		}
+		store.SetCheckPoint(key)
+		return nil
+	}, "tipset", key.String())
+}
+
+func (a *adminAPI) AdminDeleteObj(ctx context.Context, obj cid.Cid, confirm bool) error {
+	return audited(ctx, "AdminDeleteObj", confirm, func() error {
+		return a.am.blockstore.Blockstore.DeleteBlock(ctx, obj)
+	}, "cid", obj.String())
+}
+
+func (a *adminAPI) AdminShutdown(ctx context.Context, confirm bool) error {
+	return audited(ctx, "AdminShutdown", confirm, func() error {
+		a.am.shutdownOnce.Do(func() {
+			close(a.am.shutdown)
+		})
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+func TestAuditedNeedsConfirm(t *testing.T) {
+	tf.UnitTest(t)
+	ctx := context.Background()
+	ran := false
+	op := func() error {
+		ran = true
+		return nil
+	}
+
+	assert.ErrorIs(t, audited(ctx, "op", false, op), ErrNotConfirmed)
+	assert.False(t, ran)
+
+	assert.NoError(t, audited(ctx, "op", true, op, "arg", 1))
+	assert.True(t, ran)
+
+	failed := errors.New("failed")
+	assert.ErrorIs(t, audited(ctx, "op", true, func() error { return failed }), failed)
+}
+
+func TestAdminShutdown(t *testing.T) {
+	tf.UnitTest(t)
+	am := NewAdminSubmodule(nil, nil)
+	api := am.API()
+
+	assert.ErrorIs(t, api.AdminShutdown(context.Background(), false), ErrNotConfirmed)
+	select {
+	case <-am.ShutdownRequested():
+		t.Fatal("shutdown without confirm")
+	default:
+	}
+
+	assert.NoError(t, api.AdminShutdown(context.Background(), true))
+	// a second call doesn't close the channel again
+	assert.NoError(t, api.AdminShutdown(context.Background(), true))
+	<-am.ShutdownRequested()
+}
@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"sync"
+
+	"github.com/filecoin-project/go-address"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/ipfs/go-cid"
+)
+
+// maxCachedActorAddrs bounds the number of addresses held by the actor listings kept for paging
+// through StateListActorsByCode, listings larger than that are not cached at all
+const maxCachedActorAddrs = 200_000
+
+type actorListKey struct {
+	root cid.Cid
+	code cid.Cid
+}
+
+// actorListCache is an lru of actor listings bounded by the total number of addresses they hold
+// rather than by the number of listings.
+type actorListCache struct {
+	lk      sync.Mutex
+	maxSize int
+	size    int
+	lists   *lru.Cache[actorListKey, []address.Address]
+}
+
+func newActorListCache(maxSize int) *actorListCache {
+	c := &actorListCache{maxSize: maxSize}
+	// the entry count is bounded by size in add, the lru only orders evictions
+	lists, err := lru.NewWithEvict[actorListKey, []address.Address](maxSize+1, func(_ actorListKey, addrs []address.Address) {
+		c.size -= len(addrs)
+	})
+	if err != nil {
+		panic(err)
+	}
+	c.lists = lists
+	return c
+}
+
+func (c *actorListCache) get(key actorListKey) ([]address.Address, bool) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	return c.lists.Get(key)
+}
+
+func (c *actorListCache) add(key actorListKey, addrs []address.Address) {
+	if len(addrs) > c.maxSize {
+		return
+	}
+
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	if c.lists.Contains(key) {
+		return
+	}
+	c.lists.Add(key, addrs)
+	c.size += len(addrs)
+	for c.size > c.maxSize {
+		c.lists.RemoveOldest()
+	}
+}
@@ -0,0 +1,49 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/testutil"
+)
+
+func TestActorListCacheBoundBySize(t *testing.T) {
+	tf.UnitTest(t)
+
+	addrs := func(n int) []address.Address {
+		out := make([]address.Address, n)
+		for i := range out {
+			out[i] = testutil.IDAddressProvider()(t)
+		}
+		return out
+	}
+	key := func() actorListKey {
+		return actorListKey{root: testutil.CidProvider(32)(t), code: testutil.CidProvider(32)(t)}
+	}
+
+	c := newActorListCache(10)
+	k1, k2, k3, k4 := key(), key(), key(), key()
+
+	c.add(k1, addrs(4))
+	c.add(k2, addrs(4))
+	_, ok := c.get(k1)
+	require.True(t, ok)
+	require.Equal(t, 8, c.size)
+
+	// k2 is the least recently used listing and makes room for k3
+	c.add(k3, addrs(4))
+	_, ok = c.get(k2)
+	require.False(t, ok)
+	_, ok = c.get(k1)
+	require.True(t, ok)
+	require.Equal(t, 8, c.size)
+
+	// a listing larger than the bound is not cached and doesn't evict anything
+	c.add(k4, addrs(11))
+	_, ok = c.get(k4)
+	require.False(t, ok)
+	require.Equal(t, 8, c.size)
+}
@@ -0,0 +1,75 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/filecoin-project/go-state-types/abi"
+
+	"github.com/filecoin-project/venus/pkg/beacon"
+	"github.com/filecoin-project/venus/pkg/chain"
+	"github.com/filecoin-project/venus/venus-shared/actors/policy"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// beaconPruneInterval is how many epochs the head advances between two prunes of the stored
+// beacon entries.
+const beaconPruneInterval = 120
+
+// beaconPruner deletes the stored beacon entries of the rounds below the finality window of the
+// head, the entries of the tipsets which may still be reorged are kept.
+type beaconPruner struct {
+	store    *chain.Store
+	schedule beacon.Schedule
+
+	pruned abi.ChainEpoch
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newBeaconPruner(store *chain.Store, schedule beacon.Schedule) *beaconPruner {
+	return &beaconPruner{store: store, schedule: schedule}
+}
+
+func (bp *beaconPruner) start(ctx context.Context) {
+	ctx, bp.cancel = context.WithCancel(ctx)
+	bp.done = make(chan struct{})
+	go bp.loop(ctx)
+}
+
+func (bp *beaconPruner) stop() {
+	if bp.cancel == nil {
+		return
+	}
+	bp.cancel()
+	<-bp.done
+}
+
+func (bp *beaconPruner) loop(ctx context.Context) {
+	defer close(bp.done)
+
+	changes := bp.store.SubHeadChanges(ctx)
+	for {
+		select {
+		case notif, ok := <-changes:
+			if !ok {
+				return
+			}
+			bp.prune(ctx, notif[len(notif)-1].Val)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (bp *beaconPruner) prune(ctx context.Context, head *types.TipSet) {
+	below := head.Height() - policy.ChainFinality
+	if below <= 0 || below-bp.pruned < beaconPruneInterval {
+		return
+	}
+	if err := bp.schedule.PruneStore(ctx, below); err != nil {
+		log.Warnf("pruning beacon entries below %d: %s", below, err)
+		return
+	}
+	bp.pruned = below
+}
@@ -2,10 +2,14 @@ package chain
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"time"
 
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/ipfs/go-cid"
 	cbor "github.com/ipfs/go-ipld-cbor"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 
 	apiwrapper "github.com/filecoin-project/venus/app/submodule/chain/v0api"
 	"github.com/filecoin-project/venus/pkg/beacon"
@@ -14,6 +18,7 @@ import (
 	"github.com/filecoin-project/venus/pkg/consensus/chainselector"
 	"github.com/filecoin-project/venus/pkg/consensusfault"
 	"github.com/filecoin-project/venus/pkg/fork"
+	"github.com/filecoin-project/venus/pkg/paramschema"
 	"github.com/filecoin-project/venus/pkg/repo"
 	"github.com/filecoin-project/venus/pkg/statemanger"
 	"github.com/filecoin-project/venus/pkg/util/ffiwrapper"
@@ -22,6 +27,7 @@ import (
 	v0api "github.com/filecoin-project/venus/venus-shared/api/chain/v0"
 	v1api "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
 	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/filecoin-project/venus/venus-shared/utils"
 )
 
 // ChainSubmodule enhances the `Node` with chain capabilities.
@@ -32,14 +38,22 @@ type ChainSubmodule struct { //nolint
 	Fork         fork.IFork
 	SystemCall   vm.SyscallsImpl
 
-	CheckPoint types.TipSetKey
-	Drand      beacon.Schedule
+	Drand beacon.Schedule
 
 	config chainConfig
 
 	Stmgr *statemanger.Stmgr
 	// Wait for confirm message
 	Waiter *chain.Waiter
+
+	snapshots   *snapshotService
+	watchdog    *chainWatchdog
+	drandGossip []*beacon.DrandGossip
+	beaconPrune *beaconPruner
+	// prefetchEpochs is how many epochs ahead the drand rounds are prefetched
+	prefetchEpochs abi.ChainEpoch
+	// paramSchemas decode the params of methods of actors without bindings
+	paramSchemas *paramschema.Registry
 }
 
 type chainConfig interface {
@@ -55,20 +69,36 @@ func NewChainSubmodule(ctx context.Context,
 	circulatiingSupplyCalculator chain.ICirculatingSupplyCalcualtor,
 ) (*ChainSubmodule, error) {
 	repo := config.Repo()
+	cacheCfg := repo.Config().ChainCache
 	// initialize chain store
-	chainStore := chain.NewStore(repo.ChainDatastore(), repo.Datastore(), config.GenesisCid(), circulatiingSupplyCalculator, chainselector.Weight)
+	chainStore := chain.NewStore(repo.ChainDatastore(), repo.Datastore(), config.GenesisCid(), circulatiingSupplyCalculator, chainselector.Weight,
+		chain.WithTipSetCacheSize(cacheCfg.TipSetCacheSize), chain.WithBlockHeaderCacheSize(cacheCfg.BlockHeaderCacheSize))
 	// drand
 	genBlk, err := chainStore.GetGenesisBlock(context.TODO())
 	if err != nil {
 		return nil, err
 	}
 
-	drand, err := beacon.DrandConfigSchedule(genBlk.Timestamp, repo.Config().NetworkParams.BlockDelay, repo.Config().NetworkParams.DrandSchedule)
-	if err != nil {
-		return nil, err
+	var drand beacon.Schedule
+	if override := repo.Config().Beacon.Schedule; len(override) > 0 {
+		if err := checkBeaconOverride(repo.Config().NetworkParams.NetworkType); err != nil {
+			return nil, err
+		}
+		drand, err = beacon.ConfigSchedule(genBlk.Timestamp, repo.Config().NetworkParams.BlockDelay, override)
+		if err != nil {
+			return nil, fmt.Errorf("invalid beacon schedule in config: %w", err)
+		}
+	} else {
+		drand, err = beacon.DrandConfigSchedule(genBlk.Timestamp, repo.Config().NetworkParams.BlockDelay, repo.Config().NetworkParams.DrandSchedule)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	messageStore := chain.NewMessageStore(config.Repo().Datastore(), repo.Config().NetworkParams.ForkUpgradeParam)
+	drand.UseStore(repo.MetaDatastore())
+
+	messageStore := chain.NewMessageStore(config.Repo().Datastore(), repo.Config().NetworkParams.ForkUpgradeParam,
+		chain.WithMessageCacheSize(cacheCfg.MessageCacheSize))
 	fork, err := fork.NewChainFork(ctx, chainStore, cbor.NewCborStore(config.Repo().Datastore()), config.Repo().Datastore(), repo.Config().NetworkParams, config.Repo().MetaDatastore())
 	if err != nil {
 		return nil, err
@@ -88,22 +118,101 @@ func NewChainSubmodule(ctx context.Context,
 		Drand:        drand,
 		config:       config,
 		Waiter:       waiter,
-		CheckPoint:   chainStore.GetCheckPoint(),
 	}
 	err = store.ChainReader.Load(context.TODO())
 	if err != nil {
 		return nil, err
 	}
+
+	if snapshotCfg := repo.Config().Snapshot; snapshotCfg != nil && snapshotCfg.Enable {
+		dir := snapshotCfg.Dir
+		if len(dir) == 0 {
+			repoPath, err := repo.Path()
+			if err != nil {
+				return nil, err
+			}
+			dir = filepath.Join(repoPath, "snapshots")
+		}
+		store.snapshots = newSnapshotService(chainStore, *snapshotCfg, dir, config.BlockTime())
+	}
+	store.beaconPrune = newBeaconPruner(chainStore, drand)
+	store.prefetchEpochs = abi.ChainEpoch(repo.Config().Beacon.PrefetchEpochs)
+	store.watchdog = newChainWatchdog(chainStore, *repo.Config().ChainWatch, config.BlockTime())
+	if manifest := repo.Config().ParamSchema.Manifest; len(manifest) > 0 {
+		store.paramSchemas, err = paramschema.Load(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("loading param schemas: %w", err)
+		}
+	}
 	return store, nil
 }
 
+// beaconOverrideNetworks are the devnets and the test networks which get reset, the only ones whose
+// beacon schedule may be replaced from the config, as any other beacon forks the node off the
+// network.
+var beaconOverrideNetworks = map[types.NetworkType]struct{}{
+	types.Network2k:        {},
+	types.NetworkDebug:     {},
+	types.NetworkForce:     {},
+	types.NetworkInterop:   {},
+	types.NetworkButterfly: {},
+}
+
+func checkBeaconOverride(networkType types.NetworkType) error {
+	if _, ok := beaconOverrideNetworks[networkType]; ok {
+		return nil
+	}
+	return fmt.Errorf("the beacon schedule of network %s can't be replaced, remove beacon.schedule from the config",
+		utils.NetworkTypeToNetworkName(networkType))
+}
+
 // Start loads the chain from disk.
 func (chain *ChainSubmodule) Start(ctx context.Context) error {
-	return chain.Fork.Start(ctx)
+	if err := chain.Fork.Start(ctx); err != nil {
+		return err
+	}
+	chain.watchdog.start(ctx)
+	chain.Drand.StartPrefetch(ctx, chain.prefetchEpochs)
+	chain.beaconPrune.start(ctx)
+	if chain.snapshots != nil {
+		return chain.snapshots.start(ctx)
+	}
+	return nil
+}
+
+// StartDrandGossip caches the drand rounds relayed on pubsub for every drand network of the
+// beacon schedule.
+func (chain *ChainSubmodule) StartDrandGossip(ctx context.Context, ps *pubsub.PubSub) {
+	topics := make(map[string]struct{})
+	for _, bp := range chain.Drand {
+		db, ok := bp.Beacon.(*beacon.DrandBeacon)
+		if !ok {
+			continue
+		}
+		g := beacon.NewDrandGossip(ps, db)
+		if _, ok := topics[g.Topic()]; ok {
+			continue
+		}
+		topics[g.Topic()] = struct{}{}
+		// the drand servers still answer when the topic can't be used
+		if err := g.Start(ctx); err != nil {
+			log.Warnf("drand gossip disabled: %s", err)
+			continue
+		}
+		chain.drandGossip = append(chain.drandGossip, g)
+	}
 }
 
 // Stop stop the chain head event
 func (chain *ChainSubmodule) Stop(ctx context.Context) {
+	for _, g := range chain.drandGossip {
+		g.Stop()
+	}
+	if chain.snapshots != nil {
+		chain.snapshots.stop()
+	}
+	chain.beaconPrune.stop()
+	chain.watchdog.stop()
 	chain.ChainReader.Stop()
 }
 
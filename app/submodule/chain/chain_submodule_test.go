@@ -0,0 +1,21 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestCheckBeaconOverride(t *testing.T) {
+	tf.UnitTest(t)
+
+	for _, nt := range []types.NetworkType{types.Network2k, types.NetworkForce, types.NetworkButterfly, types.NetworkInterop} {
+		require.NoError(t, checkBeaconOverride(nt), nt)
+	}
+	for _, nt := range []types.NetworkType{types.NetworkMainnet, types.NetworkCalibnet} {
+		require.ErrorContains(t, checkBeaconOverride(nt), "can't be replaced", nt)
+	}
+}
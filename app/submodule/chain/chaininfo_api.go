@@ -25,9 +25,12 @@ import (
 	cbg "github.com/whyrusleeping/cbor-gen"
 	"go.opencensus.io/tag"
 
+	"github.com/filecoin-project/venus/pkg/beacon"
 	"github.com/filecoin-project/venus/pkg/chain"
 	"github.com/filecoin-project/venus/pkg/constants"
 	"github.com/filecoin-project/venus/pkg/fork"
+	"github.com/filecoin-project/venus/pkg/paramschema"
+	"github.com/filecoin-project/venus/pkg/state/tree"
 	"github.com/filecoin-project/venus/pkg/statemanger"
 	"github.com/filecoin-project/venus/venus-shared/actors"
 	v1api "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
@@ -251,6 +254,174 @@ func (cia *chainInfoAPI) ChainGetMessagesInTipset(ctx context.Context, key types
 	return out, nil
 }
 
+// ChainFsck executes again the tipsets of the chain between from and to, newest first, and
+// reports the state and receipt roots differing from the ones committed to by the child tipset or
+// stored by the node.
+func (cia *chainInfoAPI) ChainFsck(ctx context.Context, from, to abi.ChainEpoch, repair bool) ([]types.ChainFsckIssue, error) {
+	store := cia.chain.ChainReader
+	head := store.GetHead()
+	if to > head.Height() {
+		to = head.Height()
+	}
+	if from < 0 || from > to {
+		return nil, fmt.Errorf("invalid range %d to %d", from, to)
+	}
+
+	ts, err := store.GetTipSetByHeight(ctx, head, to, true)
+	if err != nil {
+		return nil, err
+	}
+	var child *types.TipSet
+	if ts.Height() < head.Height() {
+		if child, err = store.GetTipSetByHeight(ctx, head, ts.Height()+1, false); err != nil {
+			return nil, err
+		}
+	}
+
+	var issues []types.ChainFsckIssue
+	for ts.Height() >= from {
+		found, err := cia.fsckTipSet(ctx, ts, child, repair)
+		if err != nil {
+			return issues, fmt.Errorf("checking tipset %s at %d: %w", ts.Key(), ts.Height(), err)
+		}
+		issues = append(issues, found...)
+		if ts.Height() == 0 {
+			break
+		}
+		child = ts
+		if ts, err = store.GetTipSet(ctx, ts.Parents()); err != nil {
+			return issues, err
+		}
+	}
+	return issues, nil
+}
+
+func (cia *chainInfoAPI) fsckTipSet(ctx context.Context, ts, child *types.TipSet, repair bool) ([]types.ChainFsckIssue, error) {
+	root, receipts, err := cia.chain.Stmgr.ExecuteTipSet(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []types.ChainFsckIssue
+	report := func(problem string, repaired bool) {
+		log.Warnf("chain fsck: tipset %s at %d: %s", ts.Key(), ts.Height(), problem)
+		issues = append(issues, types.ChainFsckIssue{Height: ts.Height(), TipSet: ts.Key(), Problem: problem, Repaired: repaired})
+	}
+
+	if child != nil {
+		if !child.ParentState().Equals(root) {
+			report(fmt.Sprintf("computed state root %s but the chain commits to %s", root, child.ParentState()), false)
+		}
+		if !child.Blocks()[0].ParentMessageReceipts.Equals(receipts) {
+			report(fmt.Sprintf("computed receipts root %s but the chain commits to %s", receipts, child.Blocks()[0].ParentMessageReceipts), false)
+		}
+	}
+
+	// tipsets never executed by the node, e.g. imported from a snapshot, have nothing stored
+	meta, err := cia.chain.ChainReader.GetTipsetMetadata(ctx, ts)
+	if err != nil || meta == nil {
+		return issues, nil
+	}
+	if meta.TipSetStateRoot.Equals(root) && meta.TipSetReceipts.Equals(receipts) {
+		return issues, nil
+	}
+	if repair {
+		if err := cia.chain.ChainReader.PutTipSetMetadata(ctx, &chain.TipSetMetadata{
+			TipSet: ts, TipSetStateRoot: root, TipSetReceipts: receipts,
+		}); err != nil {
+			return issues, fmt.Errorf("repairing stored results: %w", err)
+		}
+	}
+	report(fmt.Sprintf("stored state root %s and receipts root %s, computed %s and %s",
+		meta.TipSetStateRoot, meta.TipSetReceipts, root, receipts), repair)
+	return issues, nil
+}
+
+// ChainGetDecodedMessagesInTipset returns the messages executed in a tipset, executing it if
+// needed, with their receipts and params decoded for the method called.
+func (cia *chainInfoAPI) ChainGetDecodedMessagesInTipset(ctx context.Context, key types.TipSetKey) ([]types.DecodedMessage, error) {
+	ts, err := cia.chain.ChainReader.GetTipSet(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if ts.Height() == 0 {
+		return nil, nil
+	}
+
+	cm, err := cia.chain.MessageStore.MessagesForTipset(ts)
+	if err != nil {
+		return nil, err
+	}
+	root, receiptRoot, err := cia.chain.Stmgr.RunStateTransition(ctx, ts, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("executing tipset %s: %w", ts.Key(), err)
+	}
+	receipts, err := cia.chain.MessageStore.LoadReceipts(ctx, receiptRoot)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) != len(cm) {
+		return nil, fmt.Errorf("tipset %s has %d messages but %d receipts", ts.Key(), len(cm), len(receipts))
+	}
+	// actors created by the tipset are only found in the state it produced
+	st, err := tree.LoadState(ctx, cia.chain.ChainReader.Store(ctx), root)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMessages(ctx, st, cia.chain.paramSchemas, cm, receipts), nil
+}
+
+// decodeMessages pairs the messages with their receipts and decodes their params against the
+// actors of st.
+func decodeMessages(ctx context.Context, st *tree.State, schemas *paramschema.Registry, cm []types.ChainMsg, receipts []types.MessageReceipt) []types.DecodedMessage {
+	out := make([]types.DecodedMessage, len(cm))
+	for i, m := range cm {
+		msg := m.VMMessage()
+		out[i] = types.DecodedMessage{
+			Cid:     m.Cid(),
+			Message: msg,
+			Receipt: receipts[i],
+		}
+		if len(msg.Params) == 0 {
+			continue
+		}
+		params, err := decodeParams(ctx, st, schemas, msg)
+		if err != nil {
+			out[i].DecodeError = err.Error()
+			continue
+		}
+		out[i].Params = params
+	}
+	return out
+}
+
+func decodeParams(ctx context.Context, st *tree.State, schemas *paramschema.Registry, msg *types.Message) (interface{}, error) {
+	act, found, err := st.GetActor(ctx, msg.To)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("actor %s not found", msg.To)
+	}
+	methodMeta, found := utils.MethodsMap[act.Code][msg.Method]
+	if !found {
+		decoded, ok, err := schemas.Decode(act.Code, msg.Method, msg.Params)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return decoded, nil
+		}
+		return nil, fmt.Errorf("method %d not found on actor %s", msg.Method, act.Code)
+	}
+	params := reflect.New(methodMeta.Params.Elem()).Interface().(cbg.CBORUnmarshaler)
+	if err := params.UnmarshalCBOR(bytes.NewReader(msg.Params)); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
 // ChainGetParentMessages returns messages stored in parent tipset of the
 // specified block.
 func (cia *chainInfoAPI) ChainGetParentMessages(ctx context.Context, bcid cid.Cid) ([]types.MessageCID, error) {
@@ -369,6 +540,21 @@ func (cia *chainInfoAPI) StateGetBeaconEntry(ctx context.Context, epoch abi.Chai
 	}
 }
 
+// BeaconGetStatus reports the health of the servers of the drand networks of the beacon schedule.
+func (cia *chainInfoAPI) BeaconGetStatus(ctx context.Context) ([]types.DrandStatus, error) {
+	var out []types.DrandStatus
+	for _, bp := range cia.chain.Drand {
+		db, ok := bp.Beacon.(*beacon.DrandBeacon)
+		if !ok {
+			continue
+		}
+		st := db.Status()
+		st.Start = bp.Start
+		out = append(out, st)
+	}
+	return out, nil
+}
+
 // StateNetworkName returns the name of the network the node is synced to
 func (cia *chainInfoAPI) StateNetworkName(ctx context.Context) (types.NetworkName, error) {
 	networkName, err := cia.getNetworkName(ctx)
@@ -660,6 +846,14 @@ func (cia *chainInfoAPI) ChainExport(ctx context.Context, nroots abi.ChainEpoch,
 	return out, nil
 }
 
+// ChainSnapshotStatus reports the periodic snapshot export and the snapshots kept on disk.
+func (cia *chainInfoAPI) ChainSnapshotStatus(ctx context.Context) (*types.SnapshotStatus, error) {
+	if cia.chain.snapshots == nil {
+		return &types.SnapshotStatus{}, nil
+	}
+	return cia.chain.snapshots.status()
+}
+
 // ChainGetPath returns a set of revert/apply operations needed to get from
 // one tipset to another, for example:
 // ```
@@ -817,6 +1011,27 @@ func (cia *chainInfoAPI) StateCall(ctx context.Context, msg *types.Message, tsk
 	return res, err
 }
 
+// StateCallWithWitness runs the given message like StateCall and also returns the state it read.
+func (cia *chainInfoAPI) StateCallWithWitness(ctx context.Context, msg *types.Message, tsk types.TipSetKey) (*types.CallWitness, error) {
+	ts, err := cia.chain.ChainReader.GetTipSet(ctx, tsk)
+	if err != nil {
+		return nil, fmt.Errorf("loading tipset %s: %v", tsk, err)
+	}
+	var res *types.CallWitness
+	for {
+		res, err = cia.chain.Stmgr.CallWithWitness(ctx, msg, ts)
+		if err != fork.ErrExpensiveFork {
+			break
+		}
+		ts, err = cia.chain.ChainReader.GetTipSet(ctx, ts.Parents())
+		if err != nil {
+			return nil, fmt.Errorf("getting parent tipset: %w", err)
+		}
+	}
+
+	return res, err
+}
+
 // StateReplay replays a given message, assuming it was included in a block in the specified tipset.
 //
 // If a tipset key is provided, and a replacing message is not found on chain,
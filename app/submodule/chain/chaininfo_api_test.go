@@ -0,0 +1,76 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/big"
+	builtintypes "github.com/filecoin-project/go-state-types/builtin"
+	miner12 "github.com/filecoin-project/go-state-types/builtin/v12/miner"
+	"github.com/filecoin-project/go-state-types/manifest"
+	cbor "github.com/ipfs/go-ipld-cbor"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/state/tree"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/actors"
+	"github.com/filecoin-project/venus/venus-shared/blockstore"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestDecodeMessages(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	st, err := tree.NewState(cbor.NewCborStore(blockstore.NewMemory()), tree.StateTreeVersion5)
+	require.NoError(t, err)
+
+	minerCode, ok := actors.GetActorCodeID(actorstypes.Version12, manifest.MinerKey)
+	require.True(t, ok)
+	maddr, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	require.NoError(t, st.SetActor(ctx, maddr, &types.Actor{Code: minerCode, Head: minerCode, Balance: big.Zero()}))
+	worker, err := address.NewIDAddress(1001)
+	require.NoError(t, err)
+
+	changeWorker := &miner12.ChangeWorkerAddressParams{NewWorker: worker, NewControlAddrs: []address.Address{worker}}
+	buf := new(bytes.Buffer)
+	require.NoError(t, changeWorker.MarshalCBOR(buf))
+
+	msgs := []*types.Message{
+		// a method with params
+		{To: maddr, From: worker, Method: builtintypes.MethodsMiner.ChangeWorkerAddress, Params: buf.Bytes(), Value: big.Zero()},
+		// a plain send has no params
+		{To: maddr, From: worker, Method: builtintypes.MethodSend, Value: abi.NewTokenAmount(10)},
+		// params that don't match the method
+		{To: maddr, From: worker, Method: builtintypes.MethodsMiner.ChangeWorkerAddress, Params: []byte{0xff}, Value: big.Zero()},
+	}
+	cm := make([]types.ChainMsg, len(msgs))
+	receipts := make([]types.MessageReceipt, len(msgs))
+	for i, msg := range msgs {
+		msg.Nonce = uint64(i)
+		cm[i] = msg
+		receipts[i] = types.MessageReceipt{GasUsed: int64(i + 1)}
+	}
+
+	out := decodeMessages(ctx, st, nil, cm, receipts)
+	require.Len(t, out, 3)
+	for i, msg := range msgs {
+		require.Equal(t, msg.Cid(), out[i].Cid)
+		require.Equal(t, msg, out[i].Message)
+		require.Equal(t, receipts[i], out[i].Receipt)
+	}
+
+	require.Empty(t, out[0].DecodeError)
+	require.Equal(t, changeWorker, out[0].Params)
+
+	require.Nil(t, out[1].Params)
+	require.Empty(t, out[1].DecodeError)
+
+	require.Nil(t, out[2].Params)
+	require.NotEmpty(t, out[2].DecodeError)
+}
@@ -46,11 +46,13 @@ var _ v1api.IMinerState = &minerStateAPI{}
 
 type minerStateAPI struct {
 	*ChainSubmodule
+
+	actorLists *actorListCache
 }
 
 // NewMinerStateAPI create miner state api
 func NewMinerStateAPI(chain *ChainSubmodule) v1api.IMinerState {
-	return &minerStateAPI{ChainSubmodule: chain}
+	return &minerStateAPI{ChainSubmodule: chain, actorLists: newActorListCache(maxCachedActorAddrs)}
 }
 
 // StateMinerSectorAllocated checks if a sector is allocated
@@ -89,6 +91,41 @@ func (msa *minerStateAPI) StateSectorGetInfo(ctx context.Context, maddr address.
 	return view.MinerSectorInfo(ctx, maddr, n)
 }
 
+// StateSectorStatus returns whether the sector number is allocated, precommitted or proven, with
+// its precommit or on-chain info and location, all read from the same state.
+func (msa *minerStateAPI) StateSectorStatus(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorStatus, error) {
+	_, view, err := msa.Stmgr.ParentStateViewTsk(ctx, tsk)
+	if err != nil {
+		return nil, fmt.Errorf("loading tipset %s: %v", tsk, err)
+	}
+	mas, err := view.LoadMinerState(ctx, maddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load miner actor state: %v", err)
+	}
+
+	var status types.SectorStatus
+	if status.Allocated, err = mas.IsAllocated(n); err != nil {
+		return nil, fmt.Errorf("checking sector %d allocation: %v", n, err)
+	}
+	if !status.Allocated {
+		return &status, nil
+	}
+	if status.PreCommit, err = mas.GetPrecommittedSector(n); err != nil {
+		return nil, fmt.Errorf("loading precommit of sector %d: %v", n, err)
+	}
+	if status.Info, err = mas.GetSector(n); err != nil {
+		return nil, fmt.Errorf("loading sector %d: %v", n, err)
+	}
+	if status.Info != nil {
+		loc, err := mas.FindSector(n)
+		if err != nil {
+			return nil, fmt.Errorf("locating sector %d: %v", n, err)
+		}
+		status.Deadline, status.Partition = loc.Deadline, loc.Partition
+	}
+	return &status, nil
+}
+
 // StateSectorPartition finds deadline/partition with the specified sector
 func (msa *minerStateAPI) StateSectorPartition(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorLocation, error) {
 	_, view, err := msa.Stmgr.ParentStateViewTsk(ctx, tsk)
@@ -379,6 +416,61 @@ func (msa *minerStateAPI) StateMarketStorageDeal(ctx context.Context, dealID abi
 	}, nil
 }
 
+// StateDealSector returns the sector the deal was activated in. The market keeps the sector of each
+// deal from actors v13, before that it would take loading every sector of the provider, so it
+// is unsupported.
+func (msa *minerStateAPI) StateDealSector(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.DealSector, error) {
+	_, view, err := msa.Stmgr.ParentStateViewTsk(ctx, tsk)
+	if err != nil {
+		return nil, fmt.Errorf("Stmgr.ParentStateViewTsk failed:%v", err)
+	}
+
+	mas, err := view.LoadMarketState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load market actor state: %v", err)
+	}
+	if mas.ActorVersion() < actorstypes.Version13 {
+		return nil, fmt.Errorf("StateDealSector is unsupported before network version %d", network.Version22)
+	}
+
+	proposals, err := mas.Proposals()
+	if err != nil {
+		return nil, err
+	}
+	proposal, found, err := proposals.Get(dealID)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("deal %d not found", dealID)
+	}
+
+	states, err := mas.States()
+	if err != nil {
+		return nil, err
+	}
+	st, found, err := states.Get(dealID)
+	if err != nil {
+		return nil, err
+	}
+	if !found || st.SectorStartEpoch() == -1 {
+		return nil, fmt.Errorf("deal %d is not activated", dealID)
+	}
+
+	minerState, err := view.LoadMinerState(ctx, proposal.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load miner actor state: %v", err)
+	}
+
+	sector, err := minerState.GetSector(st.SectorNumber())
+	if err != nil {
+		return nil, err
+	}
+	if sector == nil {
+		return nil, fmt.Errorf("sector %d of deal %d not found", st.SectorNumber(), dealID)
+	}
+	return &types.DealSector{Miner: proposal.Provider, SectorNumber: sector.SectorNumber, SealedCID: sector.SealedCID}, nil
+}
+
 func (msa *minerStateAPI) StateGetAllocationIdForPendingDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (verifreg.AllocationId, error) {
 	_, view, err := msa.Stmgr.ParentStateViewTsk(ctx, tsk)
 	if err != nil {
@@ -843,6 +935,79 @@ func (msa *minerStateAPI) StateMinerInitialPledgeCollateral(ctx context.Context,
 	return big.Div(big.Mul(initialPledge, initialPledgeNum), initialPledgeDen), nil
 }
 
+// StateMinerFaultFee returns the penalty charged for each proving period the given sectors stay faulty
+func (msa *minerStateAPI) StateMinerFaultFee(ctx context.Context, maddr address.Address, sectors []abi.SectorNumber, tsk types.TipSetKey) (big.Int, error) {
+	return msa.sectorPenalty(ctx, maddr, tsk, func(_ *types.TipSet, mas lminer.State, rewardSmoothed, powerSmoothed builtin.FilterEstimate) (abi.TokenAmount, error) {
+		return mas.FaultFee(sectors, rewardSmoothed, powerSmoothed)
+	})
+}
+
+// StateMinerTerminationFee returns the penalty charged for terminating the given sectors at the tipset
+func (msa *minerStateAPI) StateMinerTerminationFee(ctx context.Context, maddr address.Address, sectors []abi.SectorNumber, tsk types.TipSetKey) (big.Int, error) {
+	return msa.sectorPenalty(ctx, maddr, tsk, func(ts *types.TipSet, mas lminer.State, rewardSmoothed, powerSmoothed builtin.FilterEstimate) (abi.TokenAmount, error) {
+		return mas.TerminationFee(sectors, ts.Height(), rewardSmoothed, powerSmoothed)
+	})
+}
+
+// sectorPenalty computes a penalty with the formulas of the miner actor version at the tipset, from
+// the reward and power estimates in the parent state of the tipset.
+func (msa *minerStateAPI) sectorPenalty(ctx context.Context,
+	maddr address.Address,
+	tsk types.TipSetKey,
+	fee func(*types.TipSet, lminer.State, builtin.FilterEstimate, builtin.FilterEstimate) (abi.TokenAmount, error),
+) (big.Int, error) {
+	ts, err := msa.ChainReader.GetTipSet(ctx, tsk)
+	if err != nil {
+		return big.Int{}, fmt.Errorf("loading tipset %s: %v", tsk, err)
+	}
+
+	_, sTree, err := msa.Stmgr.ParentState(ctx, ts)
+	if err != nil {
+		return big.Int{}, fmt.Errorf("loading tipset(%s) parent state failed: %v", tsk, err)
+	}
+
+	store := msa.ChainReader.Store(ctx)
+	var powerSmoothed builtin.FilterEstimate
+	if act, found, err := sTree.GetActor(ctx, power.Address); err != nil {
+		return big.Int{}, fmt.Errorf("loading power actor: %v", err)
+	} else if !found {
+		return big.Int{}, fmt.Errorf("power actor not found")
+	} else if s, err := power.Load(store, act); err != nil {
+		return big.Int{}, fmt.Errorf("loading power actor state: %v", err)
+	} else if p, err := s.TotalPowerSmoothed(); err != nil {
+		return big.Int{}, fmt.Errorf("failed to determine total power: %v", err)
+	} else {
+		powerSmoothed = p
+	}
+
+	var rewardSmoothed builtin.FilterEstimate
+	if act, found, err := sTree.GetActor(ctx, reward.Address); err != nil {
+		return big.Int{}, fmt.Errorf("loading reward actor: %v", err)
+	} else if !found {
+		return big.Int{}, fmt.Errorf("reward actor not found")
+	} else if s, err := reward.Load(store, act); err != nil {
+		return big.Int{}, fmt.Errorf("loading reward actor state: %v", err)
+	} else if r, err := s.ThisEpochRewardSmoothed(); err != nil {
+		return big.Int{}, fmt.Errorf("failed to determine reward estimate: %v", err)
+	} else {
+		rewardSmoothed = r
+	}
+
+	mact, found, err := sTree.GetActor(ctx, maddr)
+	if err != nil {
+		return big.Int{}, fmt.Errorf("loading miner actor %s: %v", maddr, err)
+	}
+	if !found {
+		return big.Int{}, fmt.Errorf("miner actor %s not found", maddr)
+	}
+	mas, err := lminer.Load(store, mact)
+	if err != nil {
+		return big.Int{}, fmt.Errorf("loading miner actor state %s: %v", maddr, err)
+	}
+
+	return fee(ts, mas, rewardSmoothed, powerSmoothed)
+}
+
 // StateVMCirculatingSupplyInternal returns an approximation of the circulating supply of Filecoin at the given tipset.
 // This is the value reported by the runtime interface to actors code.
 func (msa *minerStateAPI) StateVMCirculatingSupplyInternal(ctx context.Context, tsk types.TipSetKey) (types.CirculatingSupply, error) {
@@ -881,6 +1046,69 @@ func (msa *minerStateAPI) StateMarketDeals(ctx context.Context, tsk types.TipSet
 	return view.StateMarketDeals(ctx, tsk)
 }
 
+// StateMarketDealStateChanges returns the deals whose state changed between the parent states of
+// the two tipsets
+func (msa *minerStateAPI) StateMarketDealStateChanges(ctx context.Context, from, to types.TipSetKey) ([]types.MarketDealStateChange, error) {
+	pre, err := msa.loadMarketState(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := msa.loadMarketState(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []types.MarketDealStateChange{}
+	if changed, err := pre.StatesChanged(cur); err != nil {
+		return nil, err
+	} else if !changed {
+		return out, nil
+	}
+
+	preStates, err := pre.States()
+	if err != nil {
+		return nil, fmt.Errorf("loading deal states: %w", err)
+	}
+	curStates, err := cur.States()
+	if err != nil {
+		return nil, fmt.Errorf("loading deal states: %w", err)
+	}
+	changes, err := market.DiffDealStates(preStates, curStates)
+	if err != nil {
+		return nil, fmt.Errorf("diffing deal states: %w", err)
+	}
+
+	dealState := func(ds market.DealState) *types.MarketDealState {
+		s := types.MakeDealState(ds)
+		return &s
+	}
+	for _, d := range changes.Added {
+		out = append(out, types.MarketDealStateChange{ID: d.ID, To: dealState(d.Deal)})
+	}
+	for _, d := range changes.Modified {
+		out = append(out, types.MarketDealStateChange{ID: d.ID, From: dealState(d.From), To: dealState(d.To)})
+	}
+	for _, d := range changes.Removed {
+		out = append(out, types.MarketDealStateChange{ID: d.ID, From: dealState(d.Deal)})
+	}
+	return out, nil
+}
+
+func (msa *minerStateAPI) loadMarketState(ctx context.Context, tsk types.TipSetKey) (market.State, error) {
+	_, state, err := msa.Stmgr.ParentStateTsk(ctx, tsk)
+	if err != nil {
+		return nil, fmt.Errorf("load state failed: %w", err)
+	}
+	act, found, err := state.GetActor(ctx, market.Address)
+	if err != nil {
+		return nil, fmt.Errorf("loading market actor: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("market actor not found")
+	}
+	return market.Load(msa.ChainReader.Store(ctx), act)
+}
+
 // StateMinerActiveSectors returns info about sectors that a given miner is actively proving.
 func (msa *minerStateAPI) StateMinerActiveSectors(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]*types.SectorOnChainInfo, error) { // TODO: only used in cli
 	_, view, err := msa.Stmgr.ParentStateViewTsk(ctx, tsk)
@@ -892,12 +1120,11 @@ func (msa *minerStateAPI) StateMinerActiveSectors(ctx context.Context, maddr add
 
 // StateLookupID retrieves the ID address of the given address
 func (msa *minerStateAPI) StateLookupID(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error) {
-	_, state, err := msa.Stmgr.ParentStateTsk(ctx, tsk)
+	ts, err := msa.ChainReader.GetTipSet(ctx, tsk)
 	if err != nil {
-		return address.Undef, fmt.Errorf("load state failed: %v", err)
+		return address.Undef, fmt.Errorf("loading tipset %s: %w", tsk, err)
 	}
-
-	return state.LookupID(addr)
+	return msa.Stmgr.LookupID(ctx, addr, ts)
 }
 
 func (msa *minerStateAPI) StateLookupRobustAddress(ctx context.Context, idAddr address.Address, tsk types.TipSetKey) (address.Address, error) {
@@ -974,6 +1201,55 @@ func (msa *minerStateAPI) StateListActors(ctx context.Context, tsk types.TipSetK
 	return out, nil
 }
 
+// StateListActorsByCode returns up to limit addresses of the actors in the state starting at offset,
+// only those with the given code cid unless code is cid.Undef. Listings up to maxCachedActorAddrs
+// addresses are cached, so paging through them doesn't walk the state tree again.
+func (msa *minerStateAPI) StateListActorsByCode(ctx context.Context, code cid.Cid, offset, limit int, tsk types.TipSetKey) ([]address.Address, error) {
+	if offset < 0 || limit <= 0 {
+		return nil, fmt.Errorf("invalid page, offset %d limit %d", offset, limit)
+	}
+
+	ts, err := msa.ChainReader.GetTipSet(ctx, tsk)
+	if err != nil {
+		return nil, fmt.Errorf("loading tipset %s: %w", tsk, err)
+	}
+	root, _, err := msa.Stmgr.RunStateTransition(ctx, ts, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("computing tipset(%s, %d) state: %w", ts.Key(), ts.Height(), err)
+	}
+
+	key := actorListKey{root: root, code: code}
+	actors, ok := msa.actorLists.get(key)
+	if !ok {
+		stat, err := tree.LoadState(ctx, msa.ChainReader.Store(ctx), root)
+		if err != nil {
+			return nil, fmt.Errorf("loading state %s: %w", root, err)
+		}
+		actors = []address.Address{}
+		err = stat.ForEach(func(addr tree.ActorKey, act *types.Actor) error {
+			if !code.Defined() || act.Code.Equals(code) {
+				actors = append(actors, addr)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		msa.actorLists.add(key, actors)
+	}
+
+	if offset >= len(actors) {
+		return []address.Address{}, nil
+	}
+	end := offset + limit
+	if end > len(actors) || end < offset {
+		end = len(actors)
+	}
+	out := make([]address.Address, end-offset)
+	copy(out, actors[offset:end])
+	return out, nil
+}
+
 // StateMinerPower returns the power of the indicated miner
 func (msa *minerStateAPI) StateMinerPower(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.MinerPower, error) {
 	_, view, err := msa.Stmgr.ParentStateViewTsk(ctx, tsk)
@@ -1266,6 +1542,14 @@ func (msa *minerStateAPI) StateDecodeParams(ctx context.Context, toAddr address.
 
 	methodMeta, found := utils.MethodsMap[act.Code][method]
 	if !found {
+		// actors without bindings, such as user actors, are decoded with the schemas of the config
+		decoded, ok, err := msa.paramSchemas.Decode(act.Code, method, params)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return decoded, nil
+		}
 		return nil, fmt.Errorf("method %d not found on actor %s", method, act.Code)
 	}
 
@@ -0,0 +1,241 @@
+package chain
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	"github.com/filecoin-project/venus/pkg/config"
+	"github.com/filecoin-project/venus/venus-shared/actors/policy"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+const snapshotPrefix = "snapshot_"
+
+// snapshotService exports a CAR snapshot of the chain into a directory every Interval epochs
+// and deletes the oldest ones beyond Retain. Snapshots are taken at the tipset finality epochs
+// behind the head, so they never contain tipsets that can still be reorged.
+type snapshotService struct {
+	store     *chain.Store
+	cfg       config.SnapshotConfig
+	dir       string
+	blockTime time.Duration
+	finality  abi.ChainEpoch
+
+	lk        sync.Mutex
+	running   bool
+	next      abi.ChainEpoch
+	lastError string
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newSnapshotService(store *chain.Store, cfg config.SnapshotConfig, dir string, blockTime time.Duration) *snapshotService {
+	return &snapshotService{
+		store:     store,
+		cfg:       cfg,
+		dir:       dir,
+		blockTime: blockTime,
+		finality:  policy.ChainFinality,
+	}
+}
+
+func (ss *snapshotService) start(ctx context.Context) error {
+	if ss.cfg.Interval <= 0 {
+		return fmt.Errorf("snapshot interval must be positive, got %d", ss.cfg.Interval)
+	}
+	if err := os.MkdirAll(ss.dir, 0o755); err != nil {
+		return fmt.Errorf("creating snapshot directory: %w", err)
+	}
+
+	ss.next = nextSnapshotHeight(ss.store.GetHead().Height()-ss.finality, ss.cfg.Interval)
+	ctx, ss.cancel = context.WithCancel(ctx)
+	ss.done = make(chan struct{})
+	go ss.loop(ctx)
+	return nil
+}
+
+func (ss *snapshotService) stop() {
+	if ss.cancel == nil {
+		return
+	}
+	ss.cancel()
+	<-ss.done
+}
+
+// loop polls the head once per block time rather than subscribing to head changes, an export
+// takes far longer than a block and would make the subscription drop.
+func (ss *snapshotService) loop(ctx context.Context) {
+	defer close(ss.done)
+
+	ticker := time.NewTicker(ss.blockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ss.tick(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tick exports a snapshot at the finalized tipset once its height reaches the next snapshot
+// height, then prunes the old snapshots.
+func (ss *snapshotService) tick(ctx context.Context) {
+	head := ss.store.GetHead()
+	final := head.Height() - ss.finality
+
+	ss.lk.Lock()
+	due := final >= ss.next
+	if due {
+		ss.running = true
+		ss.next = nextSnapshotHeight(final, ss.cfg.Interval)
+	}
+	ss.lk.Unlock()
+	if !due {
+		return
+	}
+
+	ts, err := ss.store.GetTipSetByHeight(ctx, head, final, true)
+	if err == nil {
+		err = ss.export(ctx, ts)
+	}
+	if err == nil {
+		err = ss.prune()
+	}
+
+	ss.lk.Lock()
+	ss.running = false
+	ss.lastError = ""
+	if err != nil {
+		log.Errorf("chain snapshot at %d failed: %s", final, err)
+		ss.lastError = err.Error()
+	}
+	ss.lk.Unlock()
+}
+
+func (ss *snapshotService) export(ctx context.Context, ts *types.TipSet) error {
+	start := time.Now()
+	path := filepath.Join(ss.dir, fmt.Sprintf("%s%d_%d.car", snapshotPrefix, ts.Height(), start.Unix()))
+	// written under a temporary name so a partial export is never listed as a snapshot
+	tmp := path + ".tmp"
+
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriterSize(f, 1<<20)
+	err = ss.store.Export(ctx, ts, ss.cfg.RecentRoots, ss.cfg.SkipOldMessages, bw)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("exporting chain: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+
+	log.Infof("exported chain snapshot at %d to %s, took %s", ts.Height(), path, time.Since(start))
+	return nil
+}
+
+// prune deletes the oldest snapshots so that at most Retain are kept.
+func (ss *snapshotService) prune() error {
+	if ss.cfg.Retain <= 0 {
+		return nil
+	}
+	snapshots, err := listSnapshots(ss.dir)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(snapshots)-ss.cfg.Retain; i++ {
+		if err := os.Remove(filepath.Join(ss.dir, snapshots[i].Name)); err != nil {
+			return fmt.Errorf("removing old snapshot: %w", err)
+		}
+		log.Infof("removed old chain snapshot %s", snapshots[i].Name)
+	}
+	return nil
+}
+
+func (ss *snapshotService) status() (*types.SnapshotStatus, error) {
+	snapshots, err := listSnapshots(ss.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ss.lk.Lock()
+	defer ss.lk.Unlock()
+	return &types.SnapshotStatus{
+		Enabled:    true,
+		Running:    ss.running,
+		NextHeight: ss.next,
+		LastError:  ss.lastError,
+		Snapshots:  snapshots,
+	}, nil
+}
+
+// nextSnapshotHeight returns the first multiple of interval after height.
+func nextSnapshotHeight(height, interval abi.ChainEpoch) abi.ChainEpoch {
+	if height < 0 {
+		return interval
+	}
+	return (height/interval + 1) * interval
+}
+
+// listSnapshots returns the snapshots in dir, oldest first.
+func listSnapshots(dir string) ([]types.SnapshotInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]types.SnapshotInfo, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, snapshotPrefix) || filepath.Ext(name) != ".car" {
+			continue
+		}
+		parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(name, snapshotPrefix), ".car"), "_")
+		if len(parts) != 2 {
+			continue
+		}
+		height, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, types.SnapshotInfo{
+			Name:   name,
+			Height: abi.ChainEpoch(height),
+			Size:   info.Size(),
+			Time:   info.ModTime(),
+		})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].Height != snapshots[j].Height {
+			return snapshots[i].Height < snapshots[j].Height
+		}
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+	return snapshots, nil
+}
@@ -0,0 +1,88 @@
+package chain
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	"github.com/filecoin-project/venus/pkg/config"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+func TestNextSnapshotHeight(t *testing.T) {
+	tf.UnitTest(t)
+
+	require.Equal(t, abi.ChainEpoch(10), nextSnapshotHeight(0, 10))
+	require.Equal(t, abi.ChainEpoch(20), nextSnapshotHeight(10, 10))
+	require.Equal(t, abi.ChainEpoch(20), nextSnapshotHeight(19, 10))
+	// the finalized height is negative while the chain is shorter than finality
+	require.Equal(t, abi.ChainEpoch(10), nextSnapshotHeight(-890, 10))
+}
+
+func TestSnapshotAtFinality(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	builder := chain.NewBuilder(t, address.Undef)
+	store := builder.Store()
+	head := builder.AppendManyOn(ctx, 10, builder.Genesis())
+	require.NoError(t, store.SetHead(ctx, head))
+
+	dir := t.TempDir()
+	ss := newSnapshotService(store, config.SnapshotConfig{
+		Enable:          true,
+		Interval:        4,
+		SkipOldMessages: true,
+		Retain:          1,
+	}, dir, 0)
+	ss.finality = 3
+	ss.next = 8
+
+	// the head is at 10, the finalized height 7 has not reached the next snapshot height yet
+	ss.tick(ctx)
+	status, err := ss.status()
+	require.NoError(t, err)
+	require.Empty(t, status.Snapshots)
+
+	// the snapshot is taken at the finalized height 8, not at the head
+	head = builder.AppendOn(ctx, head, 1)
+	require.NoError(t, store.SetHead(ctx, head))
+	ss.tick(ctx)
+	status, err = ss.status()
+	require.NoError(t, err)
+	require.Empty(t, status.LastError)
+	require.Equal(t, abi.ChainEpoch(12), status.NextHeight)
+	require.Len(t, status.Snapshots, 1)
+	require.Equal(t, abi.ChainEpoch(8), status.Snapshots[0].Height)
+	require.True(t, strings.HasPrefix(status.Snapshots[0].Name, "snapshot_8_"), status.Snapshots[0].Name)
+	require.NotContains(t, status.Snapshots[0].Name, string(os.PathSeparator))
+
+	final, err := store.GetTipSetByHeight(ctx, head, 8, true)
+	require.NoError(t, err)
+	f, err := os.Open(filepath.Join(dir, status.Snapshots[0].Name))
+	require.NoError(t, err)
+	header, err := car.ReadHeader(bufio.NewReader(f))
+	require.NoError(t, f.Close())
+	require.NoError(t, err)
+	require.Equal(t, final.Cids(), header.Roots)
+
+	// a newer snapshot replaces the old one beyond Retain
+	head = builder.AppendManyOn(ctx, 4, head)
+	require.NoError(t, store.SetHead(ctx, head))
+	ss.tick(ctx)
+	status, err = ss.status()
+	require.NoError(t, err)
+	require.Empty(t, status.LastError)
+	require.Len(t, status.Snapshots, 1)
+	require.Equal(t, abi.ChainEpoch(12), status.Snapshots[0].Height)
+	require.Equal(t, abi.ChainEpoch(16), status.NextHeight)
+}
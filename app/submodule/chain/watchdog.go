@@ -0,0 +1,305 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs-force-community/metrics"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	"github.com/filecoin-project/venus/pkg/config"
+	"github.com/filecoin-project/venus/venus-shared/api"
+	v1api "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// referenceLookback is how far below the lowest of the two heads the chains of the node and of a
+// reference node are compared, so a tipset that is still being propagated isn't reported.
+const referenceLookback = 5
+
+const (
+	webhookTimeout = 10 * time.Second
+	// referenceTimeout bounds each call to a reference node, so an unresponsive one doesn't hold
+	// up the watchdog.
+	referenceTimeout = 10 * time.Second
+)
+
+var (
+	stallAlertCnt      = metrics.NewCounter("chain/watchdog_stall", "Number of times the head stopped advancing for longer than the configured epochs")
+	reorgAlertCnt      = metrics.NewCounter("chain/watchdog_deep_reorg", "Number of reorgs deeper than the configured depth")
+	divergenceAlertCnt = metrics.NewCounter("chain/watchdog_divergence", "Number of times the chain diverged from a reference node")
+)
+
+const (
+	alertStall      = "stall"
+	alertDeepReorg  = "deep_reorg"
+	alertDivergence = "divergence"
+)
+
+// chainAlert is the body posted to the webhook.
+type chainAlert struct {
+	Kind    string         `json:"kind"`
+	Message string         `json:"message"`
+	Height  abi.ChainEpoch `json:"height"`
+	Time    time.Time      `json:"time"`
+}
+
+// referenceNode is the part of the full node api used to compare chains.
+type referenceNode interface {
+	ChainHead(ctx context.Context) (*types.TipSet, error)
+	ChainGetTipSetByHeight(ctx context.Context, height abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error)
+}
+
+// reference is a reference node, connected lazily so one that is unreachable at startup is
+// retried on the next check.
+type reference struct {
+	addr   string
+	token  string
+	node   referenceNode
+	closer jsonrpc.ClientCloser
+}
+
+func dialReference(ctx context.Context, addr, token string) (referenceNode, jsonrpc.ClientCloser, error) {
+	return v1api.DialFullNodeRPC(ctx, addr, token, nil)
+}
+
+// chainWatchdog raises alerts when the head stops advancing, when a deep reorg happens and when
+// the chain diverges from the one of a reference node.
+type chainWatchdog struct {
+	store     *chain.Store
+	cfg       config.ChainWatchConfig
+	blockTime time.Duration
+	client    *http.Client
+
+	refs    []*reference
+	dial    func(ctx context.Context, addr, token string) (referenceNode, jsonrpc.ClientCloser, error)
+	timeout time.Duration
+
+	lastHead    abi.ChainEpoch
+	lastAdvance time.Time
+	stalled     bool
+	diverged    map[string]bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	alerts sync.WaitGroup
+}
+
+func newChainWatchdog(store *chain.Store, cfg config.ChainWatchConfig, blockTime time.Duration) *chainWatchdog {
+	return &chainWatchdog{
+		store:     store,
+		cfg:       cfg,
+		blockTime: blockTime,
+		client:    &http.Client{Timeout: webhookTimeout},
+		dial:      dialReference,
+		timeout:   referenceTimeout,
+		diverged:  make(map[string]bool),
+	}
+}
+
+func (cw *chainWatchdog) start(ctx context.Context) {
+	for _, ref := range cw.cfg.ReferenceNodes {
+		info := api.ParseApiInfo(ref)
+		r := &reference{addr: info.Addr, token: string(info.Token)}
+		if err := cw.connect(ctx, r); err != nil {
+			log.Warnf("connecting to reference node %s, retrying on the next check: %s", r.addr, err)
+		}
+		cw.refs = append(cw.refs, r)
+	}
+
+	cw.lastHead = cw.store.GetHead().Height()
+	cw.lastAdvance = time.Now()
+
+	ctx, cw.cancel = context.WithCancel(ctx)
+	cw.done = make(chan struct{})
+	go cw.loop(ctx)
+}
+
+func (cw *chainWatchdog) stop() {
+	if cw.cancel == nil {
+		return
+	}
+	cw.cancel()
+	<-cw.done
+	cw.alerts.Wait()
+	for _, r := range cw.refs {
+		if r.closer != nil {
+			r.closer()
+		}
+	}
+}
+
+func (cw *chainWatchdog) connect(ctx context.Context, r *reference) error {
+	ctx, cancel := context.WithTimeout(ctx, cw.timeout)
+	defer cancel()
+	node, closer, err := cw.dial(ctx, r.addr, r.token)
+	if err != nil {
+		return err
+	}
+	r.node, r.closer = node, closer
+	return nil
+}
+
+func (cw *chainWatchdog) loop(ctx context.Context) {
+	defer close(cw.done)
+
+	changes := cw.store.SubHeadChanges(ctx)
+	ticker := time.NewTicker(cw.blockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case notif, ok := <-changes:
+			if !ok {
+				return
+			}
+			cw.headChanged(ctx, notif)
+		case <-ticker.C:
+			cw.checkStall(ctx)
+			cw.checkReferences(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (cw *chainWatchdog) headChanged(ctx context.Context, notif []*types.HeadChange) {
+	var reverted int
+	for _, change := range notif {
+		if change.Type == types.HCRevert {
+			reverted++
+		}
+	}
+	head := notif[len(notif)-1].Val
+	if cw.cfg.ReorgDepth > 0 && reverted >= cw.cfg.ReorgDepth {
+		reorgAlertCnt.Tick(ctx)
+		cw.alert(ctx, alertDeepReorg, head.Height(), fmt.Sprintf("reorg reverted %d tipsets, new head %s at %d", reverted, head.Key(), head.Height()))
+	}
+
+	if head.Height() > cw.lastHead {
+		if cw.stalled {
+			log.Infof("chain head advancing again at %d", head.Height())
+		}
+		cw.lastHead = head.Height()
+		cw.lastAdvance = time.Now()
+		cw.stalled = false
+	}
+}
+
+// checkStall alerts once each time the head stays at the same height for StallEpochs.
+func (cw *chainWatchdog) checkStall(ctx context.Context) {
+	if cw.cfg.StallEpochs <= 0 || cw.stalled {
+		return
+	}
+	since := time.Since(cw.lastAdvance)
+	if since < time.Duration(cw.cfg.StallEpochs)*cw.blockTime {
+		return
+	}
+	cw.stalled = true
+	stallAlertCnt.Tick(ctx)
+	cw.alert(ctx, alertStall, cw.lastHead, fmt.Sprintf("chain head has not advanced past %d for %s", cw.lastHead, since.Truncate(time.Second)))
+}
+
+// checkReferences alerts once each time the chain stops matching the one of a reference node.
+func (cw *chainWatchdog) checkReferences(ctx context.Context) {
+	for _, r := range cw.refs {
+		addr := r.addr
+		if r.node == nil {
+			if err := cw.connect(ctx, r); err != nil {
+				log.Warnf("connecting to reference node %s: %s", addr, err)
+				continue
+			}
+		}
+		same, height, err := cw.compare(ctx, r.node)
+		if err != nil {
+			log.Warnf("comparing chain with reference node %s: %s", addr, err)
+			continue
+		}
+		if same {
+			if cw.diverged[addr] {
+				log.Infof("chain matches reference node %s again at %d", addr, height)
+			}
+			cw.diverged[addr] = false
+			continue
+		}
+		if cw.diverged[addr] {
+			continue
+		}
+		cw.diverged[addr] = true
+		divergenceAlertCnt.Tick(ctx)
+		cw.alert(ctx, alertDivergence, height, fmt.Sprintf("chain differs from reference node %s at %d", addr, height))
+	}
+}
+
+func (cw *chainWatchdog) compare(ctx context.Context, ref referenceNode) (bool, abi.ChainEpoch, error) {
+	ctx, cancel := context.WithTimeout(ctx, cw.timeout)
+	defer cancel()
+
+	refHead, err := ref.ChainHead(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	head := cw.store.GetHead()
+
+	height := head.Height()
+	if refHead.Height() < height {
+		height = refHead.Height()
+	}
+	height -= referenceLookback
+	if height <= 0 {
+		return true, height, nil
+	}
+
+	refTS, err := ref.ChainGetTipSetByHeight(ctx, height, refHead.Key())
+	if err != nil {
+		return false, height, err
+	}
+	ts, err := cw.store.GetTipSetByHeight(ctx, head, height, true)
+	if err != nil {
+		return false, height, err
+	}
+	return ts.Equals(refTS), height, nil
+}
+
+func (cw *chainWatchdog) alert(ctx context.Context, kind string, height abi.ChainEpoch, msg string) {
+	log.Errorf("chain watchdog %s alert: %s", kind, msg)
+	if len(cw.cfg.Webhook) == 0 {
+		return
+	}
+
+	// the webhook is posted in the background so a slow one doesn't delay the checks
+	cw.alerts.Add(1)
+	go func() {
+		defer cw.alerts.Done()
+		cw.post(ctx, kind, height, msg)
+	}()
+}
+
+func (cw *chainWatchdog) post(ctx context.Context, kind string, height abi.ChainEpoch, msg string) {
+	body, err := json.Marshal(chainAlert{Kind: kind, Message: msg, Height: height, Time: time.Now()})
+	if err != nil {
+		log.Errorf("marshaling chain alert: %s", err)
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cw.cfg.Webhook, bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("creating chain alert request: %s", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := cw.client.Do(req)
+	if err != nil {
+		log.Errorf("posting chain alert: %s", err)
+		return
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		log.Errorf("posting chain alert: webhook returned %s", resp.Status)
+	}
+}
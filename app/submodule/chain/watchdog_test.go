@@ -0,0 +1,136 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	"github.com/filecoin-project/venus/pkg/config"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// fakeReference serves a chain from the store of a builder, or hangs until the call times out.
+type fakeReference struct {
+	store *chain.Store
+	head  *types.TipSet
+	hang  bool
+}
+
+func (f *fakeReference) ChainHead(ctx context.Context) (*types.TipSet, error) {
+	if f.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return f.head, nil
+}
+
+func (f *fakeReference) ChainGetTipSetByHeight(ctx context.Context, height abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error) {
+	ts, err := f.store.GetTipSet(ctx, tsk)
+	if err != nil {
+		return nil, err
+	}
+	return f.store.GetTipSetByHeight(ctx, ts, height, true)
+}
+
+func newTestWatchdog(t *testing.T, cfg config.ChainWatchConfig) (*chainWatchdog, *chain.Builder) {
+	ctx := context.Background()
+	builder := chain.NewBuilder(t, address.Undef)
+	head := builder.AppendManyOn(ctx, 20, builder.Genesis())
+	require.NoError(t, builder.Store().SetHead(ctx, head))
+
+	// the block time is long enough for the loop to never tick, the checks are run by the tests
+	cw := newChainWatchdog(builder.Store(), cfg, time.Hour)
+	cw.timeout = 100 * time.Millisecond
+	return cw, builder
+}
+
+func TestWatchdogRetriesUnreachableReference(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	cw, builder := newTestWatchdog(t, config.ChainWatchConfig{ReferenceNodes: []string{"token:/ip4/127.0.0.1/tcp/1234"}})
+	ref := &fakeReference{store: builder.Store(), head: builder.Store().GetHead()}
+
+	var dials int
+	cw.dial = func(ctx context.Context, addr, token string) (referenceNode, jsonrpc.ClientCloser, error) {
+		dials++
+		if dials == 1 {
+			return nil, nil, errors.New("connection refused")
+		}
+		return ref, func() {}, nil
+	}
+
+	// the unreachable reference doesn't fail the start
+	cw.start(ctx)
+	defer cw.stop()
+	require.Equal(t, 1, dials)
+	require.Nil(t, cw.refs[0].node)
+
+	cw.checkReferences(ctx)
+	require.Equal(t, 2, dials)
+	require.NotNil(t, cw.refs[0].node)
+	require.False(t, cw.diverged[cw.refs[0].addr])
+
+	// a connected reference isn't dialed again
+	cw.checkReferences(ctx)
+	require.Equal(t, 2, dials)
+}
+
+func TestWatchdogReferenceTimeout(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	cw, builder := newTestWatchdog(t, config.ChainWatchConfig{})
+	hanging := &fakeReference{store: builder.Store(), hang: true}
+	cw.refs = []*reference{{addr: "hanging", node: hanging}}
+
+	start := time.Now()
+	cw.checkReferences(ctx)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.False(t, cw.diverged["hanging"])
+}
+
+func TestWatchdogDivergenceAlert(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	var lk sync.Mutex
+	var alerts []chainAlert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a chainAlert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&a))
+		lk.Lock()
+		alerts = append(alerts, a)
+		lk.Unlock()
+	}))
+	defer srv.Close()
+
+	cw, builder := newTestWatchdog(t, config.ChainWatchConfig{Webhook: srv.URL})
+	// the reference node follows a fork of the chain of the node
+	fork := builder.AppendManyOn(ctx, 20, builder.Genesis())
+	cw.refs = []*reference{{addr: "ref", node: &fakeReference{store: builder.Store(), head: fork}}}
+
+	cw.checkReferences(ctx)
+	cw.checkReferences(ctx)
+	cw.alerts.Wait()
+
+	// the divergence is posted once while it lasts
+	lk.Lock()
+	defer lk.Unlock()
+	require.Len(t, alerts, 1)
+	require.Equal(t, alertDivergence, alerts[0].Kind)
+	require.Equal(t, abi.ChainEpoch(20-referenceLookback), alerts[0].Height)
+	require.True(t, cw.diverged["ref"])
+}
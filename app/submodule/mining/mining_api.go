@@ -6,14 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	cbor "github.com/ipfs/go-ipld-cbor"
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-state-types/abi"
 	acrypto "github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/go-state-types/network"
 
+	"github.com/ipfs-force-community/metrics"
 	"github.com/ipfs/go-cid"
+	logging "github.com/ipfs/go-log/v2"
 
 	ffi "github.com/filecoin-project/filecoin-ffi"
 
@@ -28,10 +32,32 @@ import (
 
 var _ v1api.IMining = &MiningAPI{}
 
+var log = logging.Logger("mining")
+
+var beaconOutageCnt = metrics.NewCounter("mining/beacon_outage_skipped_epochs", "Number of epochs for which no mining base was returned because the beacon entries were unavailable")
+
 type MiningAPI struct { //nolint
 	Ming *MiningModule
 }
 
+// beaconEntries fetches the beacon entries for mining on round, waiting at most the configured
+// beacon timeout. Once it fails because drand is down the epoch is lost, the miner asks again
+// for a later epoch and produces on it as soon as the entries come back.
+func (miningAPI *MiningAPI) beaconEntries(ctx context.Context, nv network.Version, round, parentEpoch abi.ChainEpoch, prev types.BeaconEntry) ([]types.BeaconEntry, error) {
+	if timeout := time.Duration(miningAPI.Ming.Config.Repo().Config().Mining.BeaconTimeout); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	entries, err := beacon.BeaconEntriesForBlock(ctx, miningAPI.Ming.ChainModule.Drand, nv, round, parentEpoch, prev)
+	if errors.Is(err, beacon.ErrBeaconUnavailable) {
+		beaconOutageCnt.Tick(ctx)
+		log.Warnf("skipping epoch %d, beacon unavailable: %s", round, err)
+	}
+	return entries, err
+}
+
 // MinerGetBaseInfo get current miner information
 func (miningAPI *MiningAPI) MinerGetBaseInfo(ctx context.Context, maddr address.Address, round abi.ChainEpoch, tsk types.TipSetKey) (*types.MiningBaseInfo, error) {
 	chainStore := miningAPI.Ming.ChainModule.ChainReader
@@ -54,7 +80,7 @@ func (miningAPI *MiningAPI) MinerGetBaseInfo(ctx context.Context, maddr address.
 
 	nv := miningAPI.Ming.ChainModule.Fork.GetNetworkVersion(ctx, ts.Height())
 
-	entries, err := beacon.BeaconEntriesForBlock(ctx, miningAPI.Ming.ChainModule.Drand, nv, round, ts.Height(), *prev)
+	entries, err := miningAPI.beaconEntries(ctx, nv, round, ts.Height(), *prev)
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +178,7 @@ func (miningAPI *MiningAPI) MinerCreateBlock(ctx context.Context, bt *types.Bloc
 	if err != nil {
 		return nil, err
 	}
+	miningAPI.Ming.stats.blockCreated(ctx, fblk.Header)
 
 	var out types.BlockMsg
 	out.Header = fblk.Header
@@ -165,6 +192,10 @@ func (miningAPI *MiningAPI) MinerCreateBlock(ctx context.Context, bt *types.Bloc
 	return &out, nil
 }
 
+func (miningAPI *MiningAPI) MinerGetStats(ctx context.Context) ([]types.MinerStats, error) {
+	return miningAPI.Ming.stats.list(), nil
+}
+
 func (miningAPI *MiningAPI) minerCreateBlock(ctx context.Context, bt *types.BlockTemplate) (*types.FullBlock, error) {
 	chainStore := miningAPI.Ming.ChainModule.ChainReader
 	messageStore := miningAPI.Ming.ChainModule.MessageStore
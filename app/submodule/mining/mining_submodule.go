@@ -1,6 +1,8 @@
 package mining
 
 import (
+	"context"
+
 	"github.com/filecoin-project/venus/app/submodule/blockstore"
 	chain2 "github.com/filecoin-project/venus/app/submodule/chain"
 	"github.com/filecoin-project/venus/app/submodule/network"
@@ -28,6 +30,8 @@ type MiningModule struct { //nolint
 	Wallet        wallet.WalletSubmodule
 	proofVerifier ffiwrapper.Verifier
 	Stmgr         *statemanger.Stmgr
+
+	stats *miningStats
 }
 
 // API create new miningAPi implement
@@ -58,5 +62,15 @@ func NewMiningModule(
 		SyncModule:    syncModule,
 		Wallet:        wallet,
 		proofVerifier: conf.Verifier(),
+		stats:         newMiningStats(chainModule.ChainReader, conf.Repo().Config().MiningStats.Miners),
 	}
 }
+
+// Start starts tracking the statistics of the configured miners.
+func (miningModule *MiningModule) Start(ctx context.Context) {
+	miningModule.stats.start(ctx)
+}
+
+func (miningModule *MiningModule) Stop() {
+	miningModule.stats.stop()
+}
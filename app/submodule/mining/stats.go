@@ -0,0 +1,284 @@
+package mining
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs-force-community/metrics"
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/filecoin-project/venus/venus-shared/types/params"
+)
+
+// statsConfirmations is how far below the head a height must be before its blocks are counted,
+// so a short reorg doesn't flip a block between won and orphaned.
+const statsConfirmations = 5
+
+var (
+	winsCnt          = metrics.NewCounterWithCategory("mining/wins", "Number of blocks of a tracked miner included in the chain")
+	orphanedCnt      = metrics.NewCounterWithCategory("mining/orphaned", "Number of blocks of a tracked miner that didn't end in the chain")
+	luckGauge        = metrics.NewInt64WithCategory("mining/luck", "Wins of a tracked miner as a percentage of its expected wins", "%")
+	createDelayGauge = metrics.NewInt64WithCategory("mining/create_delay", "Delay between the start of the epoch and the creation of the last block of a tracked miner", "ms")
+)
+
+type minerStats struct {
+	expected   float64
+	share      float64
+	wins       uint64
+	orphaned   uint64
+	created    uint64
+	delayTotal time.Duration
+	delayMax   time.Duration
+}
+
+// miningStats compares the wins of the configured miners with the wins expected from their share
+// of the network power, and counts the blocks they produced which were orphaned.
+type miningStats struct {
+	store *chain.Store
+	// shares returns the share of the network power of the tracked miners eligible to win at ts
+	shares func(ctx context.Context, ts *types.TipSet) (map[address.Address]float64, error)
+
+	lk      sync.Mutex
+	miners  map[address.Address]*minerStats
+	since   abi.ChainEpoch
+	counted abi.ChainEpoch
+	// seen holds the blocks of the tracked miners, by height, until the height is counted
+	seen map[abi.ChainEpoch]map[cid.Cid]address.Address
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newMiningStats(store *chain.Store, miners []address.Address) *miningStats {
+	ms := &miningStats{
+		store:  store,
+		miners: make(map[address.Address]*minerStats, len(miners)),
+		seen:   make(map[abi.ChainEpoch]map[cid.Cid]address.Address),
+	}
+	ms.shares = ms.powerShares
+	for _, m := range miners {
+		ms.miners[m] = &minerStats{}
+	}
+	return ms
+}
+
+func (ms *miningStats) start(ctx context.Context) {
+	if len(ms.miners) == 0 {
+		return
+	}
+	ms.counted = ms.store.GetHead().Height() - statsConfirmations
+	if ms.counted < 0 {
+		ms.counted = 0
+	}
+	ms.since = ms.counted + 1
+
+	ctx, ms.cancel = context.WithCancel(ctx)
+	ms.done = make(chan struct{})
+	go ms.loop(ctx)
+}
+
+func (ms *miningStats) stop() {
+	if ms.cancel == nil {
+		return
+	}
+	ms.cancel()
+	<-ms.done
+}
+
+func (ms *miningStats) loop(ctx context.Context) {
+	defer close(ms.done)
+
+	changes := ms.store.SubHeadChanges(ctx)
+	for {
+		select {
+		case notif, ok := <-changes:
+			if !ok {
+				return
+			}
+			for _, change := range notif {
+				if change.Type == types.HCApply {
+					for _, blk := range change.Val.Blocks() {
+						ms.blockSeen(blk)
+					}
+				}
+			}
+			ms.count(ctx, notif[len(notif)-1].Val)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// blockCreated records a block created through this node.
+func (ms *miningStats) blockCreated(ctx context.Context, blk *types.BlockHeader) {
+	if !ms.blockSeen(blk) {
+		return
+	}
+	delay := time.Since(time.Unix(int64(blk.Timestamp), 0))
+
+	ms.lk.Lock()
+	defer ms.lk.Unlock()
+	st := ms.miners[blk.Miner]
+	st.created++
+	st.delayTotal += delay
+	if delay > st.delayMax {
+		st.delayMax = delay
+	}
+	createDelayGauge.Set(ctx, blk.Miner.String(), delay.Milliseconds())
+}
+
+// blockSeen keeps the block until its height is counted, it returns false when the miner of the
+// block isn't tracked.
+func (ms *miningStats) blockSeen(blk *types.BlockHeader) bool {
+	ms.lk.Lock()
+	defer ms.lk.Unlock()
+
+	if _, ok := ms.miners[blk.Miner]; !ok {
+		return false
+	}
+	if blk.Height <= ms.counted {
+		return true
+	}
+	if ms.seen[blk.Height] == nil {
+		ms.seen[blk.Height] = make(map[cid.Cid]address.Address)
+	}
+	ms.seen[blk.Height][blk.Cid()] = blk.Miner
+	return true
+}
+
+// count counts the heights which are now confirmed below head.
+func (ms *miningStats) count(ctx context.Context, head *types.TipSet) {
+	for h := ms.counted + 1; h <= head.Height()-statsConfirmations; h++ {
+		ts, err := ms.store.GetTipSetByHeight(ctx, head, h, true)
+		if err != nil {
+			log.Warnf("loading tipset at %d for mining stats: %s", h, err)
+			return
+		}
+		if ts.Height() == h {
+			if err := ms.updateShares(ctx, ts); err != nil {
+				log.Warnf("loading power at %d for mining stats: %s", h, err)
+				return
+			}
+		} else {
+			// a null round, nobody won but the miners were still expected to
+			ts = nil
+		}
+		ms.countHeight(ctx, h, ts)
+	}
+}
+
+func (ms *miningStats) updateShares(ctx context.Context, ts *types.TipSet) error {
+	shares, err := ms.shares(ctx, ts)
+	if err != nil {
+		return err
+	}
+
+	ms.lk.Lock()
+	defer ms.lk.Unlock()
+	for m, st := range ms.miners {
+		st.share = shares[m]
+	}
+	return nil
+}
+
+func (ms *miningStats) powerShares(ctx context.Context, ts *types.TipSet) (map[address.Address]float64, error) {
+	view, err := ms.store.ParentStateView(ts)
+	if err != nil {
+		return nil, err
+	}
+	pas, err := view.LoadPowerState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	total, err := pas.TotalPower()
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make(map[address.Address]float64, len(ms.miners))
+	for m := range ms.miners {
+		claim, found, err := pas.MinerPower(m)
+		if err != nil {
+			return nil, err
+		}
+		if !found || total.QualityAdjPower.IsZero() {
+			continue
+		}
+		eligible, err := pas.MinerNominalPowerMeetsConsensusMinimum(m)
+		if err != nil {
+			return nil, err
+		}
+		if !eligible {
+			continue
+		}
+		shares[m], _ = new(big.Rat).SetFrac(claim.QualityAdjPower.Int, total.QualityAdjPower.Int).Float64()
+	}
+	return shares, nil
+}
+
+// countHeight counts the wins and orphans at h, ts is the tipset at h or nil for a null round.
+func (ms *miningStats) countHeight(ctx context.Context, h abi.ChainEpoch, ts *types.TipSet) {
+	ms.lk.Lock()
+	defer ms.lk.Unlock()
+
+	won := make(map[cid.Cid]struct{})
+	if ts != nil {
+		for _, blk := range ts.Blocks() {
+			if st, ok := ms.miners[blk.Miner]; ok {
+				st.wins++
+				winsCnt.Tick(ctx, blk.Miner.String())
+				won[blk.Cid()] = struct{}{}
+			}
+		}
+	}
+	for c, m := range ms.seen[h] {
+		if _, ok := won[c]; !ok {
+			ms.miners[m].orphaned++
+			orphanedCnt.Tick(ctx, m.String())
+			log.Warnf("block %s of %s at %d was orphaned", c, m, h)
+		}
+	}
+	delete(ms.seen, h)
+
+	for m, st := range ms.miners {
+		st.expected += float64(params.BlocksPerEpoch) * st.share
+		if st.expected > 0 {
+			luckGauge.Set(ctx, m.String(), int64(float64(st.wins)*100/st.expected))
+		}
+	}
+	ms.counted = h
+}
+
+func (ms *miningStats) list() []types.MinerStats {
+	ms.lk.Lock()
+	defer ms.lk.Unlock()
+
+	out := make([]types.MinerStats, 0, len(ms.miners))
+	for m, st := range ms.miners {
+		stats := types.MinerStats{
+			Miner:          m,
+			Since:          ms.since,
+			Through:        ms.counted,
+			ExpectedWins:   st.expected,
+			Wins:           st.wins,
+			Orphaned:       st.orphaned,
+			Created:        st.created,
+			MaxCreateDelay: st.delayMax,
+		}
+		if st.created > 0 {
+			stats.AvgCreateDelay = st.delayTotal / time.Duration(st.created)
+		}
+		out = append(out, stats)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Miner.String() < out[j].Miner.String()
+	})
+	return out
+}
@@ -0,0 +1,86 @@
+package mining
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestMiningStats(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	builder := chain.NewBuilder(t, address.Undef)
+	genesis := builder.Genesis()
+
+	// the single blocks of the builder are all from the tracked miner, the chain has one of its
+	// blocks and one of another miner at height 1, while its other block there is orphaned
+	orphan := builder.AppendOn(ctx, genesis, 1).Blocks()[0]
+	ts1 := builder.AppendOn(ctx, genesis, 2)
+	tracked, other := ts1.Blocks()[0], ts1.Blocks()[1]
+	if tracked.Miner != orphan.Miner {
+		tracked, other = other, tracked
+	}
+	require.Equal(t, tracked.Miner, orphan.Miner)
+	require.NotEqual(t, tracked.Miner, other.Miner)
+	require.NotEqual(t, tracked.Cid(), orphan.Cid())
+	// height 2 is a null round
+	ts3 := builder.BuildOneOn(ctx, ts1, func(b *chain.BlockBuilder) { b.IncHeight(1) })
+	require.Equal(t, abi.ChainEpoch(3), ts3.Height())
+	head := builder.AppendManyOn(ctx, 5, ts3)
+	require.Equal(t, abi.ChainEpoch(8), head.Height())
+
+	ms := newMiningStats(builder.Store(), []address.Address{tracked.Miner})
+	ms.shares = func(context.Context, *types.TipSet) (map[address.Address]float64, error) {
+		return map[address.Address]float64{tracked.Miner: 0.2, other.Miner: 0.5}, nil
+	}
+	ms.since = 1
+
+	require.True(t, ms.blockSeen(tracked))
+	require.True(t, ms.blockSeen(orphan))
+	require.False(t, ms.blockSeen(other))
+	unconfirmed, err := builder.Store().GetTipSetByHeight(ctx, head, 6, true)
+	require.NoError(t, err)
+	require.True(t, ms.blockSeen(unconfirmed.Blocks()[0]))
+
+	// only the heights statsConfirmations below the head are counted
+	ms.count(ctx, head)
+	require.Equal(t, abi.ChainEpoch(3), ms.counted)
+	require.NotContains(t, ms.seen, abi.ChainEpoch(1))
+	require.Contains(t, ms.seen, abi.ChainEpoch(6))
+
+	// a block at a counted height isn't kept
+	require.True(t, ms.blockSeen(orphan))
+	require.NotContains(t, ms.seen, abi.ChainEpoch(1))
+
+	ms.blockCreated(ctx, unconfirmed.Blocks()[0])
+	ms.blockCreated(ctx, other)
+
+	stats := ms.list()
+	require.Len(t, stats, 1)
+	st := stats[0]
+	require.Equal(t, tracked.Miner, st.Miner)
+	require.Equal(t, abi.ChainEpoch(1), st.Since)
+	require.Equal(t, abi.ChainEpoch(3), st.Through)
+	// the blocks at 1 and 3 are won, the null round at 2 still counts towards the expected wins
+	require.Equal(t, uint64(2), st.Wins)
+	require.Equal(t, uint64(1), st.Orphaned)
+	require.InDelta(t, 3.0, st.ExpectedWins, 1e-9)
+	require.Equal(t, uint64(1), st.Created)
+	require.Equal(t, st.MaxCreateDelay, st.AvgCreateDelay)
+
+	// counting resumes from the last counted height as the head advances
+	head = builder.AppendManyOn(ctx, 2, head)
+	ms.count(ctx, head)
+	stats = ms.list()
+	require.Equal(t, abi.ChainEpoch(5), stats[0].Through)
+	require.Equal(t, uint64(4), stats[0].Wins)
+	require.InDelta(t, 5.0, stats[0].ExpectedWins, 1e-9)
+}
@@ -0,0 +1,145 @@
+package mpool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/venus/pkg/config"
+	"github.com/filecoin-project/venus/pkg/constants"
+	"github.com/filecoin-project/venus/pkg/messagepool"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// autoBumper replaces the messages of the configured addresses which stay pending for a number of
+// epochs with copies paying a higher premium, as long as the fee cap stays below the ceiling.
+type autoBumper struct {
+	mpool     *messagepool.MessagePool
+	signer    *messagepool.MessageSigner
+	cfg       config.MpoolAutoBumpConfig
+	blockTime time.Duration
+
+	// capped holds the stuck messages which can't be bumped under the ceiling, so it's logged once
+	capped map[cid.Cid]struct{}
+}
+
+func newAutoBumper(mpool *messagepool.MessagePool, signer *messagepool.MessageSigner, cfg config.MpoolAutoBumpConfig, blockTime time.Duration) *autoBumper {
+	return &autoBumper{
+		mpool:     mpool,
+		signer:    signer,
+		cfg:       cfg,
+		blockTime: blockTime,
+		capped:    make(map[cid.Cid]struct{}),
+	}
+}
+
+func (ab *autoBumper) run(ctx context.Context, changes <-chan []*types.HeadChange) {
+	for {
+		select {
+		case notif, ok := <-changes:
+			if !ok {
+				return
+			}
+			head := notif[len(notif)-1]
+			if head.Type != types.HCApply {
+				continue
+			}
+			// while catching up the head moves faster than messages can be included
+			nearsync := time.Duration(pubsubMsgsSyncEpochs) * ab.blockTime
+			if constants.Clock.Since(time.Unix(int64(head.Val.MinTimestamp()), 0)) > nearsync {
+				continue
+			}
+			ab.bumpAll(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (ab *autoBumper) bumpAll(ctx context.Context) {
+	ratio := ab.mpool.GetConfig().ReplaceByFeeRatio
+	maxFeeCap := abi.TokenAmount{Int: ab.cfg.MaxFeeCap.Int}
+
+	var stuck []*types.SignedMessage
+	for _, addr := range ab.cfg.Addresses {
+		stuck = append(stuck, ab.mpool.PendingOlderThan(ctx, addr, ab.cfg.Epochs)...)
+	}
+	bumps, capped := planBumps(stuck, ratio, maxFeeCap)
+	for c, b := range capped {
+		if _, logged := ab.capped[c]; !logged {
+			log.Warnf("message %s from %s with nonce %d is stuck but a premium of %s is above the fee cap ceiling %s",
+				c, b.msg.Message.From, b.msg.Message.Nonce, b.premium, maxFeeCap)
+		}
+	}
+	for _, b := range bumps {
+		if err := ab.bump(ctx, b); err != nil {
+			log.Warnf("bumping message %s from %s with nonce %d: %s", b.msg.Cid(), b.msg.Message.From, b.msg.Message.Nonce, err)
+		}
+	}
+
+	ab.capped = make(map[cid.Cid]struct{}, len(capped))
+	for c := range capped {
+		ab.capped[c] = struct{}{}
+	}
+}
+
+// bumpPlan is the replacement fees of a stuck message.
+type bumpPlan struct {
+	msg     *types.SignedMessage
+	premium abi.TokenAmount
+	feeCap  abi.TokenAmount
+}
+
+// planBumps returns the replacements of the stuck messages, and by cid those which can't be
+// replaced under maxFeeCap.
+func planBumps(stuck []*types.SignedMessage, ratio types.Percent, maxFeeCap abi.TokenAmount) ([]bumpPlan, map[cid.Cid]bumpPlan) {
+	var bumps []bumpPlan
+	capped := make(map[cid.Cid]bumpPlan)
+	for _, m := range stuck {
+		premium, feeCap, ok := bumpedFees(&m.Message, ratio, maxFeeCap)
+		b := bumpPlan{msg: m, premium: premium, feeCap: feeCap}
+		if !ok {
+			capped[m.Cid()] = b
+			continue
+		}
+		bumps = append(bumps, b)
+	}
+	return bumps, capped
+}
+
+func (ab *autoBumper) bump(ctx context.Context, b bumpPlan) error {
+	m, premium, feeCap := b.msg, b.premium, b.feeCap
+	msg := m.Message
+	msg.GasPremium = premium
+	msg.GasFeeCap = feeCap
+
+	smsg, err := ab.signer.SignReplacement(ctx, &msg)
+	if err != nil {
+		return err
+	}
+	c, err := ab.mpool.Push(ctx, smsg)
+	if err != nil {
+		return fmt.Errorf("pushing replacement: %w", err)
+	}
+	log.Infof("bumped message %s from %s with nonce %d to %s, premium %s -> %s, fee cap %s -> %s",
+		m.Cid(), msg.From, msg.Nonce, c, m.Message.GasPremium, premium, m.Message.GasFeeCap, feeCap)
+	return nil
+}
+
+// bumpedFees returns the premium and fee cap of the replacement of msg. The premium is raised by
+// the replace by fee ratio, and at least by the minimum the mpool accepts for a replacement. The
+// fee cap is raised by as much as the premium, keeping the room left for the base fee, and
+// limited to maxFeeCap, but never lowered below the fee cap of msg. It returns false when the
+// premium needed to replace msg is above the fee cap.
+func bumpedFees(msg *types.Message, ratio types.Percent, maxFeeCap abi.TokenAmount) (abi.TokenAmount, abi.TokenAmount, bool) {
+	premium := big.Max(messagepool.ComputeRBF(msg.GasPremium, ratio), messagepool.ComputeMinRBF(msg.GasPremium))
+	feeCap := big.Add(msg.GasFeeCap, big.Sub(premium, msg.GasPremium))
+	if feeCap.GreaterThan(maxFeeCap) {
+		feeCap = big.Max(maxFeeCap, msg.GasFeeCap)
+	}
+	return premium, feeCap, premium.LessThanEqual(feeCap)
+}
@@ -0,0 +1,93 @@
+package mpool
+
+import (
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestBumpedFees(t *testing.T) {
+	tf.UnitTest(t)
+
+	for name, tc := range map[string]struct {
+		ratio                       types.Percent
+		premium, feeCap, maxFeeCap  int64
+		expectPremium, expectFeeCap int64
+		ok                          bool
+	}{
+		"raised by the ratio":              {ratio: 125, premium: 100, feeCap: 1000, maxFeeCap: 10000, expectPremium: 126, expectFeeCap: 1026, ok: true},
+		"at least the minimum replacement": {ratio: 100, premium: 100, feeCap: 1000, maxFeeCap: 10000, expectPremium: 111, expectFeeCap: 1011, ok: true},
+		"fee cap limited by the ceiling":   {ratio: 125, premium: 100, feeCap: 1000, maxFeeCap: 1010, expectPremium: 126, expectFeeCap: 1010, ok: true},
+		"fee cap never lowered":            {ratio: 125, premium: 100, feeCap: 1000, maxFeeCap: 500, expectPremium: 126, expectFeeCap: 1000, ok: true},
+		"premium above the fee cap":        {ratio: 125, premium: 100, feeCap: 120, maxFeeCap: 120, expectPremium: 126, expectFeeCap: 120, ok: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			msg := &types.Message{GasPremium: abi.NewTokenAmount(tc.premium), GasFeeCap: abi.NewTokenAmount(tc.feeCap)}
+			premium, feeCap, ok := bumpedFees(msg, tc.ratio, abi.NewTokenAmount(tc.maxFeeCap))
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, abi.NewTokenAmount(tc.expectPremium), premium)
+			require.Equal(t, abi.NewTokenAmount(tc.expectFeeCap), feeCap)
+			require.True(t, feeCap.GreaterThanEqual(msg.GasFeeCap))
+		})
+	}
+}
+
+func TestPlanBumps(t *testing.T) {
+	tf.UnitTest(t)
+
+	from, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	stuck := func(nonce uint64, premium, feeCap int64) *types.SignedMessage {
+		return &types.SignedMessage{
+			Message: types.Message{
+				From:       from,
+				To:         from,
+				Nonce:      nonce,
+				Value:      big.Zero(),
+				GasLimit:   1000,
+				GasPremium: abi.NewTokenAmount(premium),
+				GasFeeCap:  abi.NewTokenAmount(feeCap),
+			},
+			Signature: crypto.Signature{Type: crypto.SigTypeSecp256k1},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		stuck  []*types.SignedMessage
+		bumped []uint64
+		capped []uint64
+	}{
+		"nothing stuck": {},
+		"all bumped":    {stuck: []*types.SignedMessage{stuck(0, 100, 1000), stuck(1, 100, 1000)}, bumped: []uint64{0, 1}},
+		"some capped":   {stuck: []*types.SignedMessage{stuck(0, 100, 1000), stuck(1, 1000, 1100), stuck(2, 100, 1000)}, bumped: []uint64{0, 2}, capped: []uint64{1}},
+		"all capped":    {stuck: []*types.SignedMessage{stuck(0, 1000, 1000)}, capped: []uint64{0}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			bumps, capped := planBumps(tc.stuck, 125, abi.NewTokenAmount(1100))
+
+			var bumped []uint64
+			for _, b := range bumps {
+				bumped = append(bumped, b.msg.Message.Nonce)
+				require.True(t, b.premium.GreaterThan(b.msg.Message.GasPremium))
+				require.True(t, b.feeCap.GreaterThanEqual(b.msg.Message.GasFeeCap))
+				require.True(t, b.premium.LessThanEqual(b.feeCap))
+			}
+			require.Equal(t, tc.bumped, bumped)
+
+			require.Len(t, capped, len(tc.capped))
+			for _, nonce := range tc.capped {
+				m := tc.stuck[nonce]
+				b, ok := capped[m.Cid()]
+				require.True(t, ok)
+				require.Equal(t, m, b.msg)
+			}
+		})
+	}
+}
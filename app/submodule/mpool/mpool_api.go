@@ -51,6 +51,8 @@ func (a *MessagePoolAPI) MpoolGetConfig(context.Context) (*types.MpoolConfig, er
 		ReplaceByFeeRatio:      cfg.ReplaceByFeeRatio,
 		PruneCooldown:          cfg.PruneCooldown,
 		GasLimitOverestimation: cfg.GasLimitOverestimation,
+		PendingTTL:             cfg.PendingTTL,
+		ExpireLocal:            cfg.ExpireLocal,
 	}, nil
 }
 
@@ -63,6 +65,8 @@ func (a *MessagePoolAPI) MpoolSetConfig(ctx context.Context, cfg *types.MpoolCon
 		ReplaceByFeeRatio:      cfg.ReplaceByFeeRatio,
 		PruneCooldown:          cfg.PruneCooldown,
 		GasLimitOverestimation: cfg.GasLimitOverestimation,
+		PendingTTL:             cfg.PendingTTL,
+		ExpireLocal:            cfg.ExpireLocal,
 	})
 }
 
@@ -314,6 +318,11 @@ func (a *MessagePoolAPI) GasEstimateGasPremium(ctx context.Context, nblocksincl
 	return a.mp.MPool.GasEstimateGasPremium(ctx, nblocksincl, sender, gaslimit, tsk, a.mp.MPool.PriceCache)
 }
 
+// MpoolGasMarket returns the distribution of the gas premiums in the pool and in the last tipsets
+func (a *MessagePoolAPI) MpoolGasMarket(ctx context.Context, tipsets int) (*types.GasMarket, error) {
+	return a.mp.MPool.GasMarket(ctx, tipsets, a.mp.MPool.PriceCache)
+}
+
 func (a *MessagePoolAPI) MpoolCheckMessages(ctx context.Context, protos []*types.MessagePrototype) ([][]types.MessageCheckStatus, error) {
 	return a.mp.MPool.CheckMessages(ctx, protos)
 }
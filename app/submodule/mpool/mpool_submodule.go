@@ -61,6 +61,7 @@ type MessagePoolSubmodule struct { //nolint
 	walletAPI    v1api.IWallet
 	networkCfg   *config.NetworkParamsConfig
 	bootstrapper bool
+	autoBump     *autoBumper
 }
 
 func OpenFilesystemJournal(lr repo.Repo) (journal.Journal, error) {
@@ -90,14 +91,22 @@ func NewMpoolSubmodule(ctx context.Context,
 		return nil, fmt.Errorf("constructing mpool: %s", err)
 	}
 
+	networkCfg := cfg.Repo().Config().NetworkParams
+	msgSigner := messagepool.NewMessageSigner(wallet.WalletIntersection(), mp, cfg.Repo().MetaDatastore())
+	var autoBump *autoBumper
+	if bumpCfg := cfg.Repo().Config().Mpool.AutoBump; len(bumpCfg.Addresses) > 0 {
+		autoBump = newAutoBumper(mp, msgSigner, bumpCfg, time.Duration(networkCfg.BlockDelay)*time.Second)
+	}
+
 	return &MessagePoolSubmodule{
 		MPool:        mp,
 		chain:        chain,
 		walletAPI:    wallet.API(),
 		network:      network,
-		networkCfg:   cfg.Repo().Config().NetworkParams,
-		msgSigner:    messagepool.NewMessageSigner(wallet.WalletIntersection(), mp, cfg.Repo().MetaDatastore()),
+		networkCfg:   networkCfg,
+		msgSigner:    msgSigner,
 		bootstrapper: cfg.Repo().Config().PubsubConfig.Bootstrapper,
+		autoBump:     autoBump,
 	}, nil
 }
 
@@ -204,6 +213,10 @@ func (mp *MessagePoolSubmodule) Start(ctx context.Context) error {
 		return err
 	}
 
+	if mp.autoBump != nil {
+		go mp.autoBump.run(ctx, mp.chain.ChainReader.SubHeadChanges(ctx))
+	}
+
 	var once sync.Once
 	subscribe := func() {
 		once.Do(func() {
@@ -100,6 +100,18 @@ func (na *networkAPI) NetAddrsListen(context.Context) (peer.AddrInfo, error) {
 	}, nil
 }
 
+// NetAddrsAnnounced returns the addresses the host listens on and the ones it advertises
+func (na *networkAPI) NetAddrsAnnounced(context.Context) (types.NetAddrs, error) {
+	listen, err := na.network.Host.Network().InterfaceListenAddresses()
+	if err != nil {
+		return types.NetAddrs{}, err
+	}
+	return types.NetAddrs{
+		Listen:    listen,
+		Announced: na.network.Host.Addrs(),
+	}, nil
+}
+
 // NetDisconnect disconnect to peer at the given address
 func (na *networkAPI) NetDisconnect(_ context.Context, p peer.ID) error {
 	return na.network.Network.Disconnect(p)
@@ -130,6 +142,24 @@ func (na *networkAPI) NetAutoNatStatus(context.Context) (types.NatInfo, error) {
 	return na.network.Network.AutoNatStatus()
 }
 
+// NetReachability reports the nat status, the addresses the node advertises and the result of
+// the last probe of each static relay
+func (na *networkAPI) NetReachability(context.Context) (types.ReachabilityInfo, error) {
+	nat, err := na.network.Network.AutoNatStatus()
+	if err != nil {
+		return types.ReachabilityInfo{}, err
+	}
+
+	info := types.ReachabilityInfo{Reachability: nat.Reachability}
+	for _, addr := range na.network.Host.Addrs() {
+		info.AdvertisedAddrs = append(info.AdvertisedAddrs, addr.String())
+	}
+	if na.network.RelayProber != nil {
+		info.Relays = na.network.RelayProber.Status()
+	}
+	return info, nil
+}
+
 // NetPubsubScores return scores for all connected and recent peers
 func (na *networkAPI) NetPubsubScores(context.Context) ([]types.PubsubScore, error) {
 	scores := na.network.ScoreKeeper.Get()
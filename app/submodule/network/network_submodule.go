@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -88,6 +89,11 @@ type NetworkSubmodule struct { //nolint
 
 	ScoreKeeper *net.ScoreKeeper
 
+	mdns io.Closer
+
+	relays      []peer.AddrInfo
+	RelayProber *net.RelayProber
+
 	cfg networkConfig
 }
 
@@ -112,6 +118,11 @@ func (networkSubmodule *NetworkSubmodule) Stop(ctx context.Context) {
 	if err := networkSubmodule.Router.(*dht.IpfsDHT).Close(); err != nil {
 		networkLogger.Errorf("error closing dht: %s", err.Error())
 	}
+	if networkSubmodule.mdns != nil {
+		if err := networkSubmodule.mdns.Close(); err != nil {
+			networkLogger.Errorf("error closing mdns: %s", err.Error())
+		}
+	}
 }
 
 type networkConfig interface {
@@ -155,8 +166,13 @@ func NewNetworkSubmodule(ctx context.Context,
 	}
 	libP2pOpts = append(libP2pOpts, libp2p.ConnectionManager(cm))
 
+	relays, err := net.ParseAddresses(ctx, swarmCfg.StaticRelays)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse static relays")
+	}
+
 	// set up host
-	rawHost, err := buildHost(ctx, config, libP2pOpts, cfg)
+	rawHost, err := buildHost(ctx, config, libP2pOpts, cfg, relays)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +247,7 @@ func NewNetworkSubmodule(ctx context.Context,
 		HelloHandler:     helloHandler,
 		cfg:              config,
 		ScoreKeeper:      sk,
+		relays:           relays,
 	}, nil
 }
 
@@ -242,6 +259,19 @@ func (networkSubmodule *NetworkSubmodule) Start(ctx context.Context) error {
 
 	networkSubmodule.exchangeServer.Register()
 
+	if networkSubmodule.cfg.Repo().Config().Swarm.EnableMDNS && !networkSubmodule.cfg.OfflineMode() {
+		mdns, err := net.NewMDNSService(ctx, networkSubmodule.Host)
+		if err != nil {
+			return errors.Wrap(err, "failed to start mdns discovery")
+		}
+		networkSubmodule.mdns = mdns
+	}
+
+	if len(networkSubmodule.relays) > 0 && !networkSubmodule.cfg.OfflineMode() {
+		networkSubmodule.RelayProber = net.NewRelayProber(networkSubmodule.Host, networkSubmodule.relays)
+		go networkSubmodule.RelayProber.Run(ctx)
+	}
+
 	return nil
 }
 
@@ -341,7 +371,7 @@ func retrieveNetworkName(ctx context.Context, genCid cid.Cid, cborStore cbor.Ipl
 
 // address determines if we are publically dialable.  If so use public
 // address, if not configure node to announce relay address.
-func buildHost(ctx context.Context, config networkConfig, libP2pOpts []libp2p.Option, cfg *config.Config) (types.RawHost, error) {
+func buildHost(ctx context.Context, config networkConfig, libP2pOpts []libp2p.Option, cfg *config.Config, relays []peer.AddrInfo) (types.RawHost, error) {
 	if config.IsRelay() {
 		publicAddr, err := ma.NewMultiaddr(cfg.Swarm.PublicRelayAddress)
 		if err != nil {
@@ -371,11 +401,22 @@ func buildHost(ctx context.Context, config networkConfig, libP2pOpts []libp2p.Op
 		return relayHost, nil
 	}
 
+	addrsFactory, err := net.AddrsFactory(cfg.Swarm.AnnounceAddresses, cfg.Swarm.NoAnnounceAddresses, cfg.Swarm.NoAnnouncePrivate)
+	if err != nil {
+		return nil, err
+	}
 	opts := []libp2p.Option{
 		libp2p.UserAgent("venus"),
 		libp2p.ChainOptions(libP2pOpts...),
 		libp2p.Ping(true),
-		libp2p.DisableRelay(),
+		libp2p.AddrsFactory(addrsFactory),
+	}
+	if len(relays) > 0 {
+		// reserve a slot on the static relays and advertise addresses through them once
+		// autonat finds the node isn't publicly reachable
+		opts = append(opts, libp2p.EnableRelay(), libp2p.EnableAutoRelayWithStaticRelays(relays))
+	} else {
+		opts = append(opts, libp2p.DisableRelay())
 	}
 
 	return libp2p.New(opts...)
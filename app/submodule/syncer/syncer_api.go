@@ -68,6 +68,17 @@ func convertSyncStateStage(srtState syncTypes.SyncStateStage) types.SyncStateSta
 	return state
 }
 
+// SyncFailures returns the most recent sync targets that failed, oldest first.
+func (sa *syncerAPI) SyncFailures(ctx context.Context) ([]types.SyncFailure, error) {
+	return sa.syncer.ChainSyncManager.BlockProposer().SyncFailures(), nil
+}
+
+// SyncListPeerHeads returns the head last announced by each connected peer, heaviest first,
+// compared with the head of the node.
+func (sa *syncerAPI) SyncListPeerHeads(ctx context.Context) ([]types.PeerHead, error) {
+	return sa.syncer.ChainSyncManager.BlockProposer().PeerHeads().List(sa.syncer.ChainModule.ChainReader.GetHead()), nil
+}
+
 // SetConcurrent set the syncer worker(go-routine) number of chain syncing
 func (sa *syncerAPI) SetConcurrent(ctx context.Context, concurrent int64) error {
 	sa.syncer.ChainSyncManager.BlockProposer().SetConcurrent(concurrent)
@@ -17,6 +17,8 @@ import (
 
 	"github.com/ipfs/go-cid"
 	logging "github.com/ipfs/go-log/v2"
+	net "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
 
@@ -130,6 +132,20 @@ func NewSyncerSubmodule(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if syncCfg := config.Repo().Config().Sync; syncCfg != nil {
+		trusted := make([]peer.ID, 0, len(syncCfg.TrustedPeers))
+		for _, p := range syncCfg.TrustedPeers {
+			pid, err := peer.Decode(p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid trusted sync peer %s", p)
+			}
+			trusted = append(trusted, pid)
+		}
+		chainSyncManager.SetCorroboration(syncCfg.MinCorroboratingPeers, trusted, config.ChainClock())
+		if syncCfg.Concurrent > 0 {
+			chainSyncManager.BlockProposer().SetConcurrent(syncCfg.Concurrent)
+		}
+	}
 
 	var slashFilter slashfilter.ISlashFilter
 	if config.Repo().Config().SlashFilterDs.Type == "local" {
@@ -151,6 +167,16 @@ func NewSyncerSubmodule(ctx context.Context,
 		return nil, err
 	}
 
+	// forget the head of a peer once it's no longer connected
+	peerHeads := chainSyncManager.BlockProposer().PeerHeads()
+	network.Host.Network().Notify(&net.NotifyBundle{
+		DisconnectedF: func(n net.Network, c net.Conn) {
+			if n.Connectedness(c.RemotePeer()) != net.Connected {
+				peerHeads.Remove(c.RemotePeer())
+			}
+		},
+	})
+
 	return &SyncerSubmodule{
 		Stmgr:            stmgr,
 		BlockstoreModule: blockstore,
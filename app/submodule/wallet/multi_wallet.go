@@ -0,0 +1,171 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/filecoin-project/go-address"
+
+	"github.com/filecoin-project/venus/app/submodule/wallet/remotewallet"
+	pconfig "github.com/filecoin-project/venus/pkg/config"
+	"github.com/filecoin-project/venus/pkg/crypto"
+	"github.com/filecoin-project/venus/pkg/wallet"
+	"github.com/filecoin-project/venus/pkg/wallet/key"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// DefaultBackend is the name routes use for the node's own wallet, local or remote.
+const DefaultBackend = "default"
+
+type namedBackend struct {
+	name string
+	wallet.WalletIntersection
+}
+
+// multiWallet signs with several wallet backends. An address with a route is always signed by
+// the backend it is routed to, other addresses by the first backend holding them, the default
+// one first. New and imported keys go to the default backend.
+type multiWallet struct {
+	backends []namedBackend
+	routes   map[address.Address]namedBackend
+}
+
+var _ wallet.WalletIntersection = &multiWallet{}
+
+// newMultiWallet connects the backends of the config and sets up the routes, def is the default backend.
+func newMultiWallet(def wallet.WalletIntersection, cfg *pconfig.WalletConfig) (*multiWallet, error) {
+	mw := &multiWallet{
+		backends: []namedBackend{{name: DefaultBackend, WalletIntersection: def}},
+		routes:   make(map[address.Address]namedBackend, len(cfg.Routes)),
+	}
+
+	names := make([]string, 0, len(cfg.Backends))
+	for name := range cfg.Backends {
+		if name == DefaultBackend {
+			return nil, fmt.Errorf("wallet backend name %s is reserved", DefaultBackend)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		backend, err := remotewallet.SetupRemoteWallet(cfg.Backends[name])
+		if err != nil {
+			return nil, fmt.Errorf("setting up wallet backend %s: %w", name, err)
+		}
+		mw.backends = append(mw.backends, namedBackend{name: name, WalletIntersection: backend})
+	}
+
+	if err := mw.setRoutes(cfg.Routes); err != nil {
+		return nil, err
+	}
+	return mw, nil
+}
+
+// setRoutes routes the addresses to the backends by name. Signing always asks for a key address,
+// so the routes of id and actor addresses would never match and are refused.
+func (mw *multiWallet) setRoutes(routes map[string]string) error {
+	for addrStr, name := range routes {
+		addr, err := address.NewFromString(addrStr)
+		if err != nil {
+			return fmt.Errorf("invalid address %s in wallet routes: %w", addrStr, err)
+		}
+		switch addr.Protocol() {
+		case address.SECP256K1, address.BLS, address.Delegated:
+		default:
+			return fmt.Errorf("address %s in wallet routes is not a key address", addr)
+		}
+		backend, ok := mw.backend(name)
+		if !ok {
+			return fmt.Errorf("address %s is routed to unknown wallet backend %s", addr, name)
+		}
+		mw.routes[addr] = backend
+	}
+	return nil
+}
+
+func (mw *multiWallet) backend(name string) (namedBackend, bool) {
+	for _, b := range mw.backends {
+		if b.name == name {
+			return b, true
+		}
+	}
+	return namedBackend{}, false
+}
+
+// find returns the backend addr is routed to, or else the first one holding it.
+func (mw *multiWallet) find(ctx context.Context, addr address.Address) (namedBackend, bool) {
+	if b, ok := mw.routes[addr]; ok {
+		return b, true
+	}
+	for _, b := range mw.backends {
+		if b.HasAddress(ctx, addr) {
+			return b, true
+		}
+	}
+	return namedBackend{}, false
+}
+
+func (mw *multiWallet) HasAddress(ctx context.Context, addr address.Address) bool {
+	// find trusts the routes without asking their backend, it asks the others itself
+	if b, ok := mw.routes[addr]; ok {
+		return b.HasAddress(ctx, addr)
+	}
+	_, ok := mw.find(ctx, addr)
+	return ok
+}
+
+func (mw *multiWallet) Addresses(ctx context.Context) []address.Address {
+	seen := make(map[address.Address]struct{})
+	var out []address.Address
+	for _, b := range mw.backends {
+		for _, addr := range b.Addresses(ctx) {
+			if _, ok := seen[addr]; ok {
+				continue
+			}
+			seen[addr] = struct{}{}
+			out = append(out, addr)
+		}
+	}
+	return out
+}
+
+func (mw *multiWallet) NewAddress(ctx context.Context, protocol address.Protocol) (address.Address, error) {
+	return mw.backends[0].NewAddress(ctx, protocol)
+}
+
+func (mw *multiWallet) DeleteAddress(ctx context.Context, addr address.Address) error {
+	b, ok := mw.find(ctx, addr)
+	if !ok {
+		return fmt.Errorf("address %s not found in any wallet backend", addr)
+	}
+	return b.DeleteAddress(ctx, addr)
+}
+
+func (mw *multiWallet) Import(ctx context.Context, ki *key.KeyInfo) (address.Address, error) {
+	return mw.backends[0].Import(ctx, ki)
+}
+
+func (mw *multiWallet) Export(ctx context.Context, addr address.Address, password string) (*key.KeyInfo, error) {
+	b, ok := mw.find(ctx, addr)
+	if !ok {
+		return nil, fmt.Errorf("address %s not found in any wallet backend", addr)
+	}
+	return b.Export(ctx, addr, password)
+}
+
+func (mw *multiWallet) WalletSign(ctx context.Context, keyAddr address.Address, msg []byte, meta types.MsgMeta) (*crypto.Signature, error) {
+	b, ok := mw.find(ctx, keyAddr)
+	if !ok {
+		return nil, fmt.Errorf("address %s not found in any wallet backend", keyAddr)
+	}
+	sig, err := b.WalletSign(ctx, keyAddr, msg, meta)
+	if err != nil {
+		return nil, fmt.Errorf("signing with wallet backend %s: %w", b.name, err)
+	}
+	return sig, nil
+}
+
+func (mw *multiWallet) HasPassword(ctx context.Context) bool {
+	return mw.backends[0].HasPassword(ctx)
+}
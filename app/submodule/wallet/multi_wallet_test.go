@@ -0,0 +1,115 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/crypto"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/pkg/wallet"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// fakeBackend holds a fixed set of addresses and signs with its name.
+type fakeBackend struct {
+	wallet.WalletIntersection
+	name  string
+	addrs []address.Address
+	// hasCalls counts the HasAddress calls, each one is a round-trip for a remote backend
+	hasCalls int
+}
+
+func (f *fakeBackend) HasAddress(_ context.Context, addr address.Address) bool {
+	f.hasCalls++
+	for _, a := range f.addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeBackend) WalletSign(_ context.Context, _ address.Address, _ []byte, _ types.MsgMeta) (*crypto.Signature, error) {
+	return &crypto.Signature{Type: crypto.SigTypeSecp256k1, Data: []byte(f.name)}, nil
+}
+
+func TestMultiWalletRoutes(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	shared, err := address.NewSecp256k1Address([]byte("shared"))
+	require.NoError(t, err)
+	routed, err := address.NewSecp256k1Address([]byte("routed"))
+	require.NoError(t, err)
+	remoteOnly, err := address.NewSecp256k1Address([]byte("remote only"))
+	require.NoError(t, err)
+	unknown, err := address.NewSecp256k1Address([]byte("unknown"))
+	require.NoError(t, err)
+
+	newWallet := func() *multiWallet {
+		return &multiWallet{
+			backends: []namedBackend{
+				{name: DefaultBackend, WalletIntersection: &fakeBackend{name: DefaultBackend, addrs: []address.Address{shared, routed}}},
+				{name: "remote", WalletIntersection: &fakeBackend{name: "remote", addrs: []address.Address{shared, routed, remoteOnly}}},
+			},
+			routes: make(map[address.Address]namedBackend),
+		}
+	}
+
+	mw := newWallet()
+	require.NoError(t, mw.setRoutes(map[string]string{routed.String(): "remote"}))
+	for _, tc := range []struct {
+		addr   address.Address
+		signer string
+	}{
+		{addr: shared, signer: DefaultBackend},
+		{addr: routed, signer: "remote"},
+		{addr: remoteOnly, signer: "remote"},
+	} {
+		sig, err := mw.WalletSign(ctx, tc.addr, nil, types.MsgMeta{})
+		require.NoError(t, err)
+		require.Equal(t, tc.signer, string(sig.Data), tc.addr)
+	}
+	_, err = mw.WalletSign(ctx, unknown, nil, types.MsgMeta{})
+	require.Error(t, err)
+
+	// each backend is asked at most once whether it has an address
+	local := mw.backends[0].WalletIntersection.(*fakeBackend)
+	remote := mw.backends[1].WalletIntersection.(*fakeBackend)
+	for _, tc := range []struct {
+		addr                    address.Address
+		has                     bool
+		localCalls, remoteCalls int
+	}{
+		{addr: shared, has: true, localCalls: 1},
+		{addr: routed, has: true, remoteCalls: 1},
+		{addr: remoteOnly, has: true, localCalls: 1, remoteCalls: 1},
+		{addr: unknown, has: false, localCalls: 1, remoteCalls: 1},
+	} {
+		local.hasCalls, remote.hasCalls = 0, 0
+		require.Equal(t, tc.has, mw.HasAddress(ctx, tc.addr), tc.addr)
+		require.Equal(t, tc.localCalls, local.hasCalls, tc.addr)
+		require.Equal(t, tc.remoteCalls, remote.hasCalls, tc.addr)
+	}
+
+	idAddr, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	actorAddr, err := address.NewActorAddress([]byte("actor"))
+	require.NoError(t, err)
+	for name, tc := range map[string]struct {
+		routes map[string]string
+		err    string
+	}{
+		"id address":      {routes: map[string]string{idAddr.String(): "remote"}, err: "not a key address"},
+		"actor address":   {routes: map[string]string{actorAddr.String(): "remote"}, err: "not a key address"},
+		"unknown backend": {routes: map[string]string{routed.String(): "ledger"}, err: "unknown wallet backend"},
+		"invalid address": {routes: map[string]string{"f0xyz": "remote"}, err: "invalid address"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorContains(t, newWallet().setRoutes(tc.routes), tc.err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/ipfs-force-community/sophon-auth/core"
+
+	"github.com/filecoin-project/venus/pkg/crypto"
+	"github.com/filecoin-project/venus/pkg/wallet"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// SignerChecker reports whether the signer is registered to the user in the auth service.
+type SignerChecker interface {
+	SignerExistInUser(ctx context.Context, user string, signer address.Address) (bool, error)
+}
+
+// authorizedWallet restricts signing requested through the api to the signers registered to the
+// user of the caller's token, so tenants sharing a node can't sign with each other's keys.
+// Signing by the node itself, which carries no permission, and by admin tokens is not restricted.
+type authorizedWallet struct {
+	wallet.WalletIntersection
+	checker SignerChecker
+}
+
+func (aw *authorizedWallet) WalletSign(ctx context.Context, keyAddr address.Address, msg []byte, meta types.MsgMeta) (*crypto.Signature, error) {
+	if err := checkSigner(ctx, aw.checker, keyAddr); err != nil {
+		return nil, err
+	}
+	return aw.WalletIntersection.WalletSign(ctx, keyAddr, msg, meta)
+}
+
+func checkSigner(ctx context.Context, checker SignerChecker, signer address.Address) error {
+	perms, ok := core.CtxGetPerm(ctx)
+	if !ok {
+		return nil
+	}
+	for _, perm := range perms {
+		if perm == core.PermAdmin {
+			return nil
+		}
+	}
+
+	user, ok := core.CtxGetName(ctx)
+	if !ok || len(user) == 0 {
+		return fmt.Errorf("signing with %s requires a token bound to a user", signer)
+	}
+	has, err := checker.SignerExistInUser(ctx, user, signer)
+	if err != nil {
+		return fmt.Errorf("checking signer %s of user %s: %w", signer, user, err)
+	}
+	if !has {
+		return fmt.Errorf("signer %s is not registered to user %s", signer, user)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
 
 	"github.com/filecoin-project/venus/app/submodule/wallet/remotewallet"
 	"github.com/filecoin-project/venus/pkg/crypto"
@@ -37,6 +38,37 @@ func (walletAPI *WalletAPI) WalletBalance(ctx context.Context, addr address.Addr
 	return actor.Balance, nil
 }
 
+// WalletBalanceAt returns the balance of addr at the tipset, less the value and maximum gas fees of
+// its pending messages when excludePending is set. The pending messages are those on top of the
+// head, so they can only be excluded from the balance at the head.
+func (walletAPI *WalletAPI) WalletBalanceAt(ctx context.Context, addr address.Address, tsk types.TipSetKey, excludePending bool) (abi.TokenAmount, error) {
+	if excludePending && !tsk.IsEmpty() {
+		if head := walletAPI.walletModule.Chain.ChainReader.GetHead(); !tsk.Equals(head.Key()) {
+			return abi.NewTokenAmount(0), fmt.Errorf("pending messages can only be excluded at the head %s, not at %s", head.Key(), tsk)
+		}
+	}
+
+	actor, err := walletAPI.walletModule.Chain.Stmgr.GetActorAtTsk(ctx, addr, tsk)
+	if err != nil {
+		if errors.Is(err, types.ErrActorNotFound) {
+			return abi.NewTokenAmount(0), nil
+		}
+		return abi.NewTokenAmount(0), err
+	}
+	if !excludePending {
+		return actor.Balance, nil
+	}
+
+	if walletAPI.walletModule.pending == nil {
+		return abi.NewTokenAmount(0), errors.New("pending messages are not available")
+	}
+	pending, err := walletAPI.walletModule.pending.PendingFunds(ctx, addr)
+	if err != nil {
+		return abi.NewTokenAmount(0), fmt.Errorf("loading pending messages of %s: %w", addr, err)
+	}
+	return big.Max(big.Sub(actor.Balance, pending), big.Zero()), nil
+}
+
 // WalletHas indicates whether the given address is in the wallet.
 func (walletAPI *WalletAPI) WalletHas(ctx context.Context, addr address.Address) (bool, error) {
 	return walletAPI.adapter.HasAddress(ctx, addr), nil
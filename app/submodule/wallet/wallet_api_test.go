@@ -0,0 +1,30 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/app/submodule/chain"
+	pchain "github.com/filecoin-project/venus/pkg/chain"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+func TestWalletBalanceAtExcludePendingOnlyAtHead(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	builder := pchain.NewBuilder(t, address.Undef)
+	parent := builder.AppendManyOn(ctx, 5, builder.Genesis())
+	head := builder.AppendOn(ctx, parent, 1)
+	require.NoError(t, builder.Store().SetHead(ctx, head))
+
+	api := &WalletAPI{walletModule: &WalletSubmodule{Chain: &chain.ChainSubmodule{ChainReader: builder.Store()}}}
+	addr, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+
+	_, err = api.WalletBalanceAt(ctx, addr, parent.Key(), true)
+	require.ErrorContains(t, err, "pending messages can only be excluded at the head")
+}
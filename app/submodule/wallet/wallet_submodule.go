@@ -3,6 +3,9 @@ package wallet
 import (
 	"context"
 
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/big"
+
 	v0api "github.com/filecoin-project/venus/venus-shared/api/chain/v0"
 	v1api "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
 
@@ -28,6 +31,14 @@ type WalletSubmodule struct { // nolint
 	adapter wallet.WalletIntersection
 	Signer  types.Signer
 	Config  *config.ConfigModule
+
+	pending PendingFunds
+}
+
+// PendingFunds returns the funds the pending messages of an address can spend, their value and
+// maximum gas fees.
+type PendingFunds interface {
+	PendingFunds(ctx context.Context, addr address.Address) (big.Int, error)
 }
 
 type walletRepo interface {
@@ -66,6 +77,13 @@ func NewWalletSubmodule(ctx context.Context,
 	} else {
 		adapter = fcWallet
 	}
+	if len(repo.Config().Wallet.Backends) > 0 || len(repo.Config().Wallet.Routes) > 0 {
+		adapter, err = newMultiWallet(adapter, repo.Config().Wallet)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to set up wallet backends")
+		}
+		log.Infof("%d extra wallet backends set up", len(repo.Config().Wallet.Backends))
+	}
 	return &WalletSubmodule{
 		Config:  cfgModule,
 		Chain:   chain,
@@ -90,6 +108,18 @@ func (wallet *WalletSubmodule) V0API() v0api.IWallet {
 	}
 }
 
+// RestrictSigners makes signing requested with a non admin token fail unless the signer is
+// registered to the token's user, it must be called before the wallet is handed to other modules.
+func (wallet *WalletSubmodule) RestrictSigners(checker SignerChecker) {
+	wallet.adapter = &authorizedWallet{WalletIntersection: wallet.adapter, checker: checker}
+}
+
+// SetPendingFunds sets where the funds spent by pending messages are read from, the message pool
+// is built after the wallet.
+func (wallet *WalletSubmodule) SetPendingFunds(pending PendingFunds) {
+	wallet.pending = pending
+}
+
 func (wallet *WalletSubmodule) WalletIntersection() wallet.WalletIntersection {
 	return wallet.adapter
 }
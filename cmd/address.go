@@ -65,16 +65,12 @@ var addrsNewCmd = &cmds.Command{
 	},
 	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
 		protocolName := req.Options["type"].(string)
-		var protocol address.Protocol
-		switch protocolName {
-		case "secp256k1":
-			protocol = address.SECP256K1
-		case "bls":
-			protocol = address.BLS
-		case "delegated":
-			protocol = address.Delegated
-		default:
-			return fmt.Errorf("unrecognized address protocol %s", protocolName)
+		protocol, err := types.ParseAddressProtocol(protocolName)
+		if err != nil {
+			return err
+		}
+		if types.AddressProtocol2SignType(protocol) == types.SigTypeUnknown {
+			return fmt.Errorf("cannot create a wallet address of protocol %s", protocolName)
 		}
 
 		if !env.(*node.Env).WalletAPI.HasPassword(req.Context) {
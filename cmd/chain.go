@@ -38,6 +38,7 @@ var chainCmd = &cmds.Command{
 		"disputer":           chainDisputeSetCmd,
 		"export":             chainExportCmd,
 		"read-obj":           chainReadObjCmd,
+		"fsck":               chainFsckCmd,
 	},
 }
 
@@ -48,6 +49,58 @@ type ChainHeadResult struct {
 	Timestamp    string
 }
 
+var chainFsckCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Execute a range of the chain again and report mismatching state or receipts",
+		ShortDescription: `
+Executes again the tipsets between the from and to epochs, newest first, comparing their state
+and receipt roots with the ones committed to by the next tipset and with the results stored by
+the node. A mismatch with the chain means the local state is corrupt or the node disagrees with
+the network. With --repair the stored results found wrong are replaced with the computed ones.
+`,
+	},
+	Options: []cmds.Option{
+		cmds.Int64Option("from", "lowest epoch to check").WithDefault(int64(-1)),
+		cmds.Int64Option("to", "highest epoch to check, the head when not set").WithDefault(int64(-1)),
+		cmds.BoolOption("repair", "replace the stored results found wrong"),
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		ctx := req.Context
+		api := env.(*node.Env).ChainAPI
+
+		from, _ := req.Options["from"].(int64)
+		to, _ := req.Options["to"].(int64)
+		repair, _ := req.Options["repair"].(bool)
+		if to < 0 {
+			head, err := api.ChainHead(ctx)
+			if err != nil {
+				return err
+			}
+			to = int64(head.Height())
+		}
+		if from < 0 {
+			return fmt.Errorf("--from is required")
+		}
+
+		issues, err := api.ChainFsck(ctx, abi.ChainEpoch(from), abi.ChainEpoch(to), repair)
+		if err != nil {
+			return err
+		}
+
+		buf := new(bytes.Buffer)
+		writer := NewSilentWriter(buf)
+		for _, issue := range issues {
+			repaired := ""
+			if issue.Repaired {
+				repaired = " (repaired)"
+			}
+			writer.Printf("%d %s: %s%s\n", issue.Height, issue.TipSet, issue.Problem, repaired)
+		}
+		writer.Printf("checked epochs %d to %d, found %d issues\n", from, to, len(issues))
+		return re.Emit(buf)
+	},
+}
+
 var chainReadObjCmd = &cmds.Command{
 	Helptext: cmds.HelpText{
 		Tagline: "Read the raw bytes of an object",
@@ -177,11 +230,10 @@ var chainSetHeadCmd = &cmds.Command{
 		cmds.StringArg("cids", true, true, "CID's of the blocks of the tipset to set the chain head to."),
 	},
 	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
-		headCids, err := cidsFromSlice(req.Arguments)
+		maybeNewHead, err := types.ParseTipSetKey(strings.Join(req.Arguments, " "))
 		if err != nil {
 			return err
 		}
-		maybeNewHead := types.NewTipSetKey(headCids...)
 		return env.(*node.Env).ChainAPI.ChainSetHead(req.Context, maybeNewHead)
 	},
 }
@@ -455,16 +507,9 @@ func ParseTipSetRef(ctx context.Context, chainAPI v1api.IChain, tss string) (*ty
 }
 
 func ParseTipSetString(ts string) ([]cid.Cid, error) {
-	strs := strings.Split(ts, ",")
-
-	var cids []cid.Cid
-	for _, s := range strs {
-		c, err := cid.Parse(strings.TrimSpace(s))
-		if err != nil {
-			return nil, err
-		}
-		cids = append(cids, c)
+	tsk, err := types.ParseTipSetKey(ts)
+	if err != nil {
+		return nil, err
 	}
-
-	return cids, nil
+	return tsk.Cids(), nil
 }
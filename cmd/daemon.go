@@ -23,6 +23,7 @@ import (
 
 	"github.com/filecoin-project/venus/app/node"
 	"github.com/filecoin-project/venus/app/paths"
+	"github.com/filecoin-project/venus/pkg/chain"
 	"github.com/filecoin-project/venus/pkg/config"
 	"github.com/filecoin-project/venus/pkg/genesis"
 	"github.com/filecoin-project/venus/pkg/journal"
@@ -203,6 +204,13 @@ func daemonRun(req *cmds.Request, re cmds.ResponseEmitter) error {
 	if err := networks.SetConfigFromNetworkType(config, config.NetworkParams.NetworkType); err != nil {
 		return fmt.Errorf("set config failed %v %v", config.NetworkParams.NetworkType, err)
 	}
+	genBlk, err := chain.GenesisBlock(req.Context, rep.ChainDatastore(), rep.Datastore())
+	if err != nil {
+		return err
+	}
+	if err := genesis.VerifyGenesis(config.NetworkParams.NetworkType, genBlk.Cid()); err != nil {
+		return err
+	}
 	log.Infof("network params: %+v", config.NetworkParams)
 	log.Infof("upgrade params: %+v", config.NetworkParams.ForkUpgradeParam)
 
@@ -417,7 +417,7 @@ func getSender(ctx context.Context, api v1api.IWallet, fromStr string) (address.
 
 func getMaxFee(maxStr string) (*types.MessageSendSpec, error) {
 	if maxStr != "" {
-		maxFee, err := types.ParseFIL(maxStr)
+		maxFee, err := types.ParseNonNegativeFIL(maxStr)
 		if err != nil {
 			return nil, fmt.Errorf("parsing max-fee: %w", err)
 		}
@@ -0,0 +1,359 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/big"
+	builtintypes "github.com/filecoin-project/go-state-types/builtin"
+	verifreg13 "github.com/filecoin-project/go-state-types/builtin/v13/verifreg"
+	"github.com/filecoin-project/go-state-types/network"
+	cmds "github.com/ipfs/go-ipfs-cmds"
+	cbor "github.com/ipfs/go-ipld-cbor"
+
+	"github.com/filecoin-project/venus/app/node"
+	"github.com/filecoin-project/venus/cmd/tablewriter"
+	"github.com/filecoin-project/venus/pkg/constants"
+	"github.com/filecoin-project/venus/venus-shared/actors"
+	"github.com/filecoin-project/venus/venus-shared/actors/adt"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin/datacap"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin/multisig"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin/verifreg"
+	"github.com/filecoin-project/venus/venus-shared/blockstore"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+var filplusCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Interact with the verified registry actor used by Filplus",
+	},
+	Subcommands: map[string]*cmds.Command{
+		"list-notaries":        filplusListNotariesCmd,
+		"list-clients":         filplusListClientsCmd,
+		"check-notary-datacap": filplusCheckNotaryDataCapCmd,
+		"check-client-datacap": filplusCheckClientDataCapCmd,
+		"grant-datacap":        filplusGrantDataCapCmd,
+		"propose-notary":       filplusProposeNotaryCmd,
+		"approve-notary":       filplusApproveNotaryCmd,
+	},
+}
+
+var filplusListNotariesCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "List all notaries and their remaining datacap",
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		ctx := req.Context
+		api := env.(*node.Env).ChainAPI
+
+		act, err := api.StateGetActor(ctx, verifreg.Address, types.EmptyTSK)
+		if err != nil {
+			return err
+		}
+		st, err := verifreg.Load(apiStore(ctx, env), act)
+		if err != nil {
+			return err
+		}
+
+		return emitDataCaps(re, "Notary", st.ForEachVerifier)
+	},
+}
+
+var filplusListClientsCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "List all verified clients and their remaining datacap",
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		ctx := req.Context
+		api := env.(*node.Env).ChainAPI
+
+		nv, err := api.StateNetworkVersion(ctx, types.EmptyTSK)
+		if err != nil {
+			return err
+		}
+
+		// since network version 17 the datacap of clients is held by the datacap actor
+		if nv >= network.Version17 {
+			act, err := api.StateGetActor(ctx, datacap.Address, types.EmptyTSK)
+			if err != nil {
+				return err
+			}
+			st, err := datacap.Load(apiStore(ctx, env), act)
+			if err != nil {
+				return err
+			}
+			return emitDataCaps(re, "Client", st.ForEachClient)
+		}
+
+		act, err := api.StateGetActor(ctx, verifreg.Address, types.EmptyTSK)
+		if err != nil {
+			return err
+		}
+		st, err := verifreg.Load(apiStore(ctx, env), act)
+		if err != nil {
+			return err
+		}
+		return emitDataCaps(re, "Client", st.ForEachClient)
+	},
+}
+
+var filplusCheckNotaryDataCapCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Check the remaining datacap of a notary",
+	},
+	Arguments: []cmds.Argument{
+		cmds.StringArg("address", true, false, "notary address"),
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		addr, err := address.NewFromString(req.Arguments[0])
+		if err != nil {
+			return err
+		}
+		dcap, err := env.(*node.Env).ChainAPI.StateVerifierStatus(req.Context, addr, types.EmptyTSK)
+		if err != nil {
+			return err
+		}
+		if dcap == nil {
+			return fmt.Errorf("%s is not a notary", addr)
+		}
+		return printOneString(re, types.SizeStr(*dcap))
+	},
+}
+
+var filplusCheckClientDataCapCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Check the remaining datacap of a verified client",
+	},
+	Arguments: []cmds.Argument{
+		cmds.StringArg("address", true, false, "client address"),
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		addr, err := address.NewFromString(req.Arguments[0])
+		if err != nil {
+			return err
+		}
+		dcap, err := env.(*node.Env).ChainAPI.StateVerifiedClientStatus(req.Context, addr, types.EmptyTSK)
+		if err != nil {
+			return err
+		}
+		if dcap == nil {
+			return fmt.Errorf("%s is not a verified client", addr)
+		}
+		return printOneString(re, types.SizeStr(*dcap))
+	},
+}
+
+var filplusGrantDataCapCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Grant datacap to a client from the datacap of a notary",
+	},
+	Arguments: []cmds.Argument{
+		cmds.StringArg("client", true, false, "client address"),
+		cmds.StringArg("allowance", true, false, "datacap in bytes to grant"),
+	},
+	Options: []cmds.Option{
+		cmds.StringOption("from", "notary address the datacap is granted from"),
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		ctx := req.Context
+		api := env.(*node.Env).ChainAPI
+
+		client, err := address.NewFromString(req.Arguments[0])
+		if err != nil {
+			return err
+		}
+		allowance, err := parseDataCap(req.Arguments[1])
+		if err != nil {
+			return err
+		}
+		from, err := fromAddrOrDefault(req, env)
+		if err != nil {
+			return err
+		}
+
+		dcap, err := api.StateVerifierStatus(ctx, from, types.EmptyTSK)
+		if err != nil {
+			return err
+		}
+		if dcap == nil {
+			return fmt.Errorf("%s is not a notary", from)
+		}
+		if dcap.LessThan(allowance) {
+			return fmt.Errorf("cannot grant more datacap than the notary has: %s > %s", allowance, dcap)
+		}
+
+		params, err := actors.SerializeParams(&verifreg13.AddVerifiedClientParams{Address: client, Allowance: allowance})
+		if err != nil {
+			return err
+		}
+
+		return pushAndWait(req, re, env, &types.Message{
+			From:   from,
+			To:     verifreg.Address,
+			Value:  big.Zero(),
+			Method: builtintypes.MethodsVerifiedRegistry.AddVerifiedClient,
+			Params: params,
+		}, fmt.Sprintf("Granted %s datacap to %s", allowance, client))
+	},
+}
+
+var filplusProposeNotaryCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Propose a new notary through the root key multisig of the verified registry",
+		ShortDescription: `
+The proposal must be approved by enough root key holders with 'venus filplus approve-notary'
+before the notary is added.
+`,
+	},
+	Arguments: []cmds.Argument{
+		cmds.StringArg("notary", true, false, "address of the new notary"),
+		cmds.StringArg("allowance", true, false, "datacap in bytes the notary can grant"),
+	},
+	Options: []cmds.Option{
+		cmds.StringOption("from", "root key holder proposing the notary"),
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		ctx := req.Context
+
+		notary, err := address.NewFromString(req.Arguments[0])
+		if err != nil {
+			return err
+		}
+		allowance, err := parseDataCap(req.Arguments[1])
+		if err != nil {
+			return err
+		}
+		from, err := fromAddrOrDefault(req, env)
+		if err != nil {
+			return err
+		}
+
+		params, err := actors.SerializeParams(&verifreg13.AddVerifierParams{Address: notary, Allowance: allowance})
+		if err != nil {
+			return err
+		}
+
+		rootKey, mb, err := rootKeyMessageBuilder(ctx, env, from)
+		if err != nil {
+			return err
+		}
+		msg, err := mb.Propose(rootKey, verifreg.Address, big.Zero(), builtintypes.MethodsVerifiedRegistry.AddVerifier, params)
+		if err != nil {
+			return err
+		}
+
+		return pushAndWait(req, re, env, msg, fmt.Sprintf("Proposed %s as notary with %s datacap", notary, allowance))
+	},
+}
+
+var filplusApproveNotaryCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Approve a pending notary proposal of the root key multisig",
+	},
+	Arguments: []cmds.Argument{
+		cmds.StringArg("txid", true, false, "id of the pending multisig transaction"),
+	},
+	Options: []cmds.Option{
+		cmds.StringOption("from", "root key holder approving the notary"),
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		ctx := req.Context
+
+		txID, err := strconv.ParseUint(req.Arguments[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("parsing txid: %w", err)
+		}
+		from, err := fromAddrOrDefault(req, env)
+		if err != nil {
+			return err
+		}
+
+		rootKey, mb, err := rootKeyMessageBuilder(ctx, env, from)
+		if err != nil {
+			return err
+		}
+		msg, err := mb.Approve(rootKey, txID, nil)
+		if err != nil {
+			return err
+		}
+
+		return pushAndWait(req, re, env, msg, fmt.Sprintf("Approved transaction %d", txID))
+	},
+}
+
+func apiStore(ctx context.Context, env cmds.Environment) adt.Store {
+	return adt.WrapStore(ctx, cbor.NewCborStore(blockstore.NewAPIBlockstore(env.(*node.Env).BlockStoreAPI)))
+}
+
+func parseDataCap(s string) (abi.StoragePower, error) {
+	dcap, err := types.BigFromString(s)
+	if err != nil {
+		return abi.StoragePower{}, fmt.Errorf("parsing datacap: %w", err)
+	}
+	if dcap.Sign() <= 0 {
+		return abi.StoragePower{}, fmt.Errorf("datacap must be positive, got %s", dcap)
+	}
+	return dcap, nil
+}
+
+// rootKeyMessageBuilder returns the root key multisig of the verified registry and a multisig
+// message builder for the current actors version.
+func rootKeyMessageBuilder(ctx context.Context, env cmds.Environment, from address.Address) (address.Address, multisig.MessageBuilder, error) {
+	api := env.(*node.Env).ChainAPI
+
+	rootKey, err := api.StateVerifiedRegistryRootKey(ctx, types.EmptyTSK)
+	if err != nil {
+		return address.Undef, nil, err
+	}
+	nv, err := api.StateNetworkVersion(ctx, types.EmptyTSK)
+	if err != nil {
+		return address.Undef, nil, err
+	}
+	av, err := actorstypes.VersionForNetwork(nv)
+	if err != nil {
+		return address.Undef, nil, err
+	}
+	return rootKey, multisig.Message(av, from), nil
+}
+
+func pushAndWait(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment, msg *types.Message, success string) error {
+	smsg, err := env.(*node.Env).MessagePoolAPI.MpoolPushMessage(req.Context, msg, nil)
+	if err != nil {
+		return err
+	}
+
+	_ = re.Emit(fmt.Sprintf("message sent, now waiting on cid: %s", smsg.Cid()))
+
+	mwait, err := env.(*node.Env).ChainAPI.StateWaitMsg(req.Context, smsg.Cid(), constants.MessageConfidence, constants.LookbackNoLimit, true)
+	if err != nil {
+		return err
+	}
+	if mwait.Receipt.ExitCode.IsError() {
+		return fmt.Errorf("message execution failed (exit code %d)", mwait.Receipt.ExitCode)
+	}
+	return re.Emit(success)
+}
+
+func emitDataCaps(re cmds.ResponseEmitter, role string, forEach func(func(address.Address, abi.StoragePower) error) error) error {
+	tw := tablewriter.New(tablewriter.Col(role), tablewriter.Col("DataCap"))
+	if err := forEach(func(addr address.Address, dcap abi.StoragePower) error {
+		tw.Write(map[string]interface{}{
+			role:      addr,
+			"DataCap": types.SizeStr(dcap),
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := tw.Flush(buf); err != nil {
+		return err
+	}
+	return re.Emit(buf)
+}
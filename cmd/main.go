@@ -132,6 +132,9 @@ Cid COMMANDS
 Evm COMMANDS
   evm                    - Commands related to the Filecoin EVM runtime
 
+Filplus COMMANDS
+  filplus                - Interact with the verified registry actor used by Filplus
+
 TOOL COMMANDS
   inspect                - Show info about the venus node
   log                    - Interact with the daemon event log output
@@ -182,6 +185,7 @@ var rootSubcmdsDaemon = map[string]*cmds.Command{
 	"paych":   paychCmd,
 	"info":    infoCmd,
 	"evm":     evmCmd,
+	"filplus": filplusCmd,
 }
 
 func init() {
@@ -41,7 +41,7 @@ func parseGasOptions(req *cmds.Request) (fbig.Int, fbig.Int, int64, error) {
 	var err error
 	feecapOption := req.Options["gas-feecap"]
 	if feecapOption != nil {
-		feecap, err = types.ParseFIL(feecapOption.(string))
+		feecap, err = types.ParseNonNegativeFIL(feecapOption.(string))
 		if err != nil {
 			return types.ZeroFIL, types.ZeroFIL, 0, errors.New("invalid gas price (specify FIL as a decimal number)")
 		}
@@ -49,7 +49,7 @@ func parseGasOptions(req *cmds.Request) (fbig.Int, fbig.Int, int64, error) {
 
 	premiumOption := req.Options["gas-premium"]
 	if premiumOption != nil {
-		premium, err = types.ParseFIL(premiumOption.(string))
+		premium, err = types.ParseNonNegativeFIL(premiumOption.(string))
 		if err != nil {
 			return types.ZeroFIL, types.ZeroFIL, 0, errors.New("invalid gas price (specify FIL as a decimal number)")
 		}
@@ -102,7 +102,7 @@ var msgSendCmd = &cmds.Command{
 			return err
 		}
 		v := req.Arguments[1]
-		val, err := types.ParseFIL(v)
+		val, err := types.ParseNonNegativeFIL(v)
 		if err != nil {
 			return fmt.Errorf("mal-formed value: %v", err)
 		}
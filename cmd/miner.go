@@ -77,7 +77,7 @@ var newMinerCmd = &cmds.Command{
 		}
 
 		gp, _ := req.Options["gas-premium"].(string)
-		gasPrice, err := types.ParseFIL(gp)
+		gasPrice, err := types.ParseNonNegativeFIL(gp)
 		if err != nil {
 			return fmt.Errorf("failed to parse gas-price flag: %s", err)
 		}
@@ -192,7 +192,7 @@ var actorWithdrawCmd = &cmds.Command{
 		}
 
 		amount := available
-		f, err := types.ParseFIL(req.Arguments[1])
+		f, err := types.ParseNonNegativeFIL(req.Arguments[1])
 		if err != nil {
 			return fmt.Errorf("parsing 'amount' argument: %v", err)
 		}
@@ -288,7 +288,7 @@ var actorRepayDebtCmd = &cmds.Command{
 		var amount abi.TokenAmount
 		fil, _ := req.Options["amount"].(string)
 		if len(fil) != 0 {
-			f, err := types.ParseFIL(fil)
+			f, err := types.ParseNonNegativeFIL(fil)
 			if err != nil {
 				return fmt.Errorf("parsing 'amount' argument: %w", err)
 			}
@@ -57,7 +57,7 @@ var addFundsCmd = &cmds.Command{
 		if err != nil {
 			return err
 		}
-		amt, err := types.ParseFIL(req.Arguments[2])
+		amt, err := types.ParseNonNegativeFIL(req.Arguments[2])
 		if err != nil {
 			return err
 		}
@@ -232,7 +232,7 @@ var voucherCreateCmd = &cmds.Command{
 		if err != nil {
 			return err
 		}
-		amtFil, err := types.ParseFIL(req.Arguments[1])
+		amtFil, err := types.ParseNonNegativeFIL(req.Arguments[1])
 		if err != nil {
 			return err
 		}
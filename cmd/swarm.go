@@ -52,6 +52,7 @@ libp2p peers on the internet.
 		"ping":           swarmPingCmd,
 		"disconnect":     disconnectCmd,
 		"reachability":   reachabilityCmd,
+		"announced":      announcedCmd,
 		"protect":        protectAddCmd,
 		"unprotect":      protectRemoveCmd,
 		"list-protected": protectListCmd,
@@ -529,6 +530,49 @@ var reachabilityCmd = &cmds.Command{
 	},
 }
 
+var announcedCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Show the addresses the node listens on and which of them it advertises",
+		ShortDescription: `
+Lists the listen addresses, marking those advertised to peers, followed by the advertised
+addresses the node doesn't listen on, such as the configured announce addresses. Use it to
+check the swarm announceAddresses, noAnnounceAddresses and noAnnouncePrivate config.
+`,
+	},
+	Run: func(req *cmds.Request, re cmds.ResponseEmitter, env cmds.Environment) error {
+		addrs, err := env.(*node.Env).NetworkAPI.NetAddrsAnnounced(req.Context)
+		if err != nil {
+			return err
+		}
+
+		announced := make(map[string]struct{}, len(addrs.Announced))
+		for _, a := range addrs.Announced {
+			announced[a.String()] = struct{}{}
+		}
+		listen := make(map[string]struct{}, len(addrs.Listen))
+
+		buf := new(bytes.Buffer)
+		writer := NewSilentWriter(buf)
+		writer.Println("Listening on:")
+		for _, a := range addrs.Listen {
+			listen[a.String()] = struct{}{}
+			if _, ok := announced[a.String()]; ok {
+				writer.Println("  ", a, "(announced)")
+			} else {
+				writer.Println("  ", a)
+			}
+		}
+		writer.Println("Announced only:")
+		for _, a := range addrs.Announced {
+			if _, ok := listen[a.String()]; !ok {
+				writer.Println("  ", a)
+			}
+		}
+
+		return re.Emit(buf)
+	},
+}
+
 var protectAddCmd = &cmds.Command{
 	Helptext: cmds.HelpText{
 		Tagline: "Add one or more peer IDs to the list of protected peer connections",
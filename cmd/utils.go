@@ -13,7 +13,6 @@ import (
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/hako/durafmt"
-	"github.com/ipfs/go-cid"
 	cmds "github.com/ipfs/go-ipfs-cmds"
 	"github.com/pkg/errors"
 
@@ -129,18 +128,6 @@ func fromAddrOrDefault(req *cmds.Request, env cmds.Environment) (address.Address
 	return addr, nil
 }
 
-func cidsFromSlice(args []string) ([]cid.Cid, error) {
-	out := make([]cid.Cid, len(args))
-	for i, arg := range args {
-		c, err := cid.Decode(arg)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = c
-	}
-	return out, nil
-}
-
 func getBlockDelay(ctx context.Context, env cmds.Environment) (uint64, error) {
 	params, err := env.(*node.Env).ChainAPI.StateGetNetworkParams(ctx)
 	if err != nil {
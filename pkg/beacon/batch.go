@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+
+	dchain "github.com/drand/drand/chain"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// pairingSuite only pairs points, which doesn't depend on the hash to curve domain of the scheme.
+var pairingSuite = bls.NewBLS12381Suite()
+
+// verifyEntriesOneByOne verifies entries in order, each one against the signature of the
+// previous one, the first one against prevEntrySig.
+func verifyEntriesOneByOne(b RandomBeacon, entries []types.BeaconEntry, prevEntrySig []byte) error {
+	for i, e := range entries {
+		if err := b.VerifyEntry(e, prevEntrySig); err != nil {
+			return fmt.Errorf("beacon entry %d (%d - %x (%d)) was invalid: %w", i, e.Round, e.Data, len(e.Data), err)
+		}
+		prevEntrySig = e.Data
+	}
+	return nil
+}
+
+// VerifyEntries verifies entries in order, each one against the signature of the previous one,
+// the first one against prevEntrySig. The signatures which aren't cached yet are checked together
+// with a random linear combination, which costs a single pairing check instead of one per entry.
+func (db *DrandBeacon) VerifyEntries(entries []types.BeaconEntry, prevEntrySig []byte) error {
+	var pending []*dchain.Beacon
+	prev := prevEntrySig
+	for i, e := range entries {
+		if be := db.getCachedValue(e.Round); be != nil {
+			if !bytes.Equal(e.Data, be.Data) {
+				return fmt.Errorf("beacon entry %d (%d) was invalid: does not match cached good value", i, e.Round)
+			}
+		} else {
+			pending = append(pending, &dchain.Beacon{PreviousSig: prev, Round: e.Round, Signature: e.Data})
+		}
+		prev = e.Data
+	}
+
+	if len(pending) > 1 {
+		err := db.verifyBatch(pending)
+		if err == nil {
+			for _, b := range pending {
+				db.cacheValue(types.BeaconEntry{Round: b.Round, Data: b.Signature})
+			}
+			return nil
+		}
+		log.Debugf("batch verification of %d beacon entries failed, verifying them one by one: %s", len(pending), err)
+	}
+	// a single entry, or a failed batch which needs the invalid entry to be found
+	return verifyEntriesOneByOne(db, entries, prevEntrySig)
+}
+
+// verifyBatch checks e(pk, Σ r_i H(m_i)) == e(g, Σ r_i σ_i) for signatures on G2, or the same with
+// the groups swapped for signatures on G1, with random 64 bit r_i so invalid signatures can't
+// cancel each other.
+func (db *DrandBeacon) verifyBatch(beacons []*dchain.Beacon) error {
+	sigGroup := db.scheme.SigGroup
+	hashes := sigGroup.Point().Null()
+	sigs := sigGroup.Point().Null()
+
+	var buf [8]byte
+	for _, b := range beacons {
+		sig := sigGroup.Point()
+		if err := sig.UnmarshalBinary(b.Signature); err != nil {
+			return fmt.Errorf("invalid signature of round %d: %w", b.Round, err)
+		}
+		hashable, ok := sigGroup.Point().(kyber.HashablePoint)
+		if !ok {
+			return fmt.Errorf("points of %s can't be hashed to", sigGroup)
+		}
+		hash := hashable.Hash(db.scheme.DigestBeacon(b))
+
+		if _, err := rand.Read(buf[:]); err != nil {
+			return err
+		}
+		r := sigGroup.Scalar().SetInt64(int64(binary.LittleEndian.Uint64(buf[:])>>1) | 1)
+
+		hashes = hashes.Add(hashes, hash.Mul(r, hash))
+		sigs = sigs.Add(sigs, sig.Mul(r, sig))
+	}
+
+	var valid bool
+	if sigGroup.PointLen() > db.scheme.KeyGroup.PointLen() {
+		// keys on G1, signatures on G2
+		valid = pairingSuite.ValidatePairing(db.pubkey, hashes, db.scheme.KeyGroup.Point().Base(), sigs)
+	} else {
+		// keys on G2, signatures on G1
+		valid = pairingSuite.ValidatePairing(hashes, db.pubkey, sigs, db.scheme.KeyGroup.Point().Base())
+	}
+	if !valid {
+		return fmt.Errorf("aggregated signature of %d beacon entries is invalid", len(beacons))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package beacon
+
+import (
+	"testing"
+
+	dchain "github.com/drand/drand/chain"
+	dcrypto "github.com/drand/drand/crypto"
+	"github.com/drand/kyber/util/random"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestVerifyEntries(t *testing.T) {
+	tf.UnitTest(t)
+	for _, id := range []string{dcrypto.DefaultSchemeID, dcrypto.SigsOnG1ID} {
+		t.Run(id, func(t *testing.T) {
+			sch, err := dcrypto.SchemeFromName(id)
+			require.NoError(t, err)
+			priv, pub := sch.AuthScheme.NewKeyPair(random.New())
+
+			newBeacon := func() *DrandBeacon {
+				lc, err := lru.New[uint64, *types.BeaconEntry](16)
+				require.NoError(t, err)
+				return &DrandBeacon{isChained: id == dcrypto.DefaultSchemeID, scheme: sch, pubkey: pub, localCache: lc}
+			}
+
+			genesisSig := []byte("genesis")
+			prev := genesisSig
+			var entries []types.BeaconEntry
+			for round := uint64(10); round < 15; round++ {
+				sig, err := sch.AuthScheme.Sign(priv, sch.DigestBeacon(&dchain.Beacon{PreviousSig: prev, Round: round}))
+				require.NoError(t, err)
+				entries = append(entries, types.BeaconEntry{Round: round, Data: sig})
+				prev = sig
+			}
+
+			db := newBeacon()
+			require.NoError(t, db.VerifyEntries(entries, genesisSig))
+			for _, e := range entries {
+				assert.NotNil(t, db.getCachedValue(e.Round))
+			}
+			// answered from the cache
+			require.NoError(t, db.VerifyEntries(entries, genesisSig))
+
+			// two signatures swapped fail, and the first invalid entry is reported
+			swapped := append([]types.BeaconEntry{}, entries...)
+			swapped[1].Data, swapped[2].Data = entries[2].Data, entries[1].Data
+			err = newBeacon().VerifyEntries(swapped, genesisSig)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "beacon entry 1 ")
+
+			// two invalid signatures whose sum is the sum of the valid ones still fail
+			p1, p2, x := sch.SigGroup.Point(), sch.SigGroup.Point(), sch.SigGroup.Point().Pick(random.New())
+			require.NoError(t, p1.UnmarshalBinary(entries[3].Data))
+			require.NoError(t, p2.UnmarshalBinary(entries[4].Data))
+			p1 = p1.Add(p1, x)
+			p2 = p2.Sub(p2, x)
+			canceling := append([]types.BeaconEntry{}, entries...)
+			canceling[3].Data, err = p1.MarshalBinary()
+			require.NoError(t, err)
+			canceling[4].Data, err = p2.MarshalBinary()
+			require.NoError(t, err)
+			pending := []*dchain.Beacon{
+				{PreviousSig: entries[2].Data, Round: 13, Signature: canceling[3].Data},
+				{PreviousSig: entries[3].Data, Round: 14, Signature: canceling[4].Data},
+			}
+			assert.Error(t, newBeacon().verifyBatch(pending))
+		})
+	}
+}
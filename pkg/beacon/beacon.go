@@ -2,6 +2,7 @@ package beacon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,10 @@ import (
 
 var log = logging.Logger("beacon")
 
+// ErrBeaconUnavailable is returned when the beacon entries needed for an epoch can't be fetched
+// or don't come back before the context is done, which usually means drand is down.
+var ErrBeaconUnavailable = errors.New("beacon entry unavailable")
+
 type Response struct {
 	Entry types.BeaconEntry
 	Err   error
@@ -31,6 +36,9 @@ type RandomBeacon interface {
 	Entry(context.Context, uint64) <-chan Response
 	// VerifyEntry(types.BeaconEntry, types.BeaconEntry) error
 	VerifyEntry(entry types.BeaconEntry, prevEntrySig []byte) error
+	// VerifyEntries verifies entries in order, each one against the signature of the previous
+	// one, the first one against prevEntrySig.
+	VerifyEntries(entries []types.BeaconEntry, prevEntrySig []byte) error
 	MaxBeaconRoundForEpoch(network.Version, abi.ChainEpoch) uint64
 	IsChained() bool
 }
@@ -91,14 +99,7 @@ func ValidateBlockValues(bSchedule Schedule, nv network.Version, h *types.BlockH
 	}
 
 	// Verify the beacon entries themselves
-	for i, e := range h.BeaconEntries {
-		if err := currBeacon.VerifyEntry(e, prevEntry.Data); err != nil {
-			return fmt.Errorf("beacon entry %d (%d - %x (%d)) was invalid: %w", i, e.Round, e.Data, len(e.Data), err)
-		}
-		prevEntry = &h.BeaconEntries[i]
-	}
-
-	return nil
+	return currBeacon.VerifyEntries(h.BeaconEntries, prevEntry.Data)
 }
 
 func BeaconEntriesForBlock(ctx context.Context, bSchedule Schedule, nv network.Version, epoch abi.ChainEpoch, parentEpoch abi.ChainEpoch, prev types.BeaconEntry) ([]types.BeaconEntry, error) { //nolint
@@ -112,13 +113,13 @@ func BeaconEntriesForBlock(ctx context.Context, bSchedule Schedule, nv network.V
 		rch := currBeacon.Entry(ctx, round-1)
 		res := <-rch
 		if res.Err != nil {
-			return nil, fmt.Errorf("getting entry %d returned error: %w", round-1, res.Err)
+			return nil, fmt.Errorf("%w: getting entry %d returned error: %w", ErrBeaconUnavailable, round-1, res.Err)
 		}
 		out[0] = res.Entry
 		rch = currBeacon.Entry(ctx, round)
 		res = <-rch
 		if res.Err != nil {
-			return nil, fmt.Errorf("getting entry %d returned error: %w", round, res.Err)
+			return nil, fmt.Errorf("%w: getting entry %d returned error: %w", ErrBeaconUnavailable, round, res.Err)
 		}
 		out[1] = res.Entry
 		return out, nil
@@ -144,12 +145,12 @@ func BeaconEntriesForBlock(ctx context.Context, bSchedule Schedule, nv network.V
 		select {
 		case resp := <-rch:
 			if resp.Err != nil {
-				return nil, fmt.Errorf("beacon entry request returned error: %w", resp.Err)
+				return nil, fmt.Errorf("%w: beacon entry request returned error: %w", ErrBeaconUnavailable, resp.Err)
 			}
 
 			out = append(out, resp.Entry)
 		case <-ctx.Done():
-			return nil, fmt.Errorf("context timed out waiting on beacon entry to come back for epoch %d: %w", epoch, ctx.Err())
+			return nil, fmt.Errorf("%w: context timed out waiting on beacon entry to come back for epoch %d: %w", ErrBeaconUnavailable, epoch, ctx.Err())
 		}
 	}
 
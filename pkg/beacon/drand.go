@@ -3,6 +3,8 @@ package beacon
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	dlog "github.com/drand/drand/log"
 	"github.com/drand/kyber"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/ipfs/go-datastore"
 	"go.uber.org/zap"
 
 	"github.com/filecoin-project/go-state-types/abi"
@@ -27,12 +30,16 @@ import (
 // randomness to the system in a way that's aligned with Filecoin rounds/epochs.
 //
 // We connect to drand peers via their public HTTP endpoints. The peers are
-// enumerated in the drandServers variable.
+// enumerated in the drandServers variable, and the ones failing are skipped
+// for a while. A DrandGossip can fill the cache
+// with the rounds relayed over pubsub.
 //
 // The root trust for the Drand chain is configured from build.DrandChain.
 type DrandBeacon struct {
 	isChained bool
 	client    dclient.Client
+	endpoints []*endpoint
+	chainHash []byte
 
 	pubkey kyber.Point
 
@@ -45,6 +52,8 @@ type DrandBeacon struct {
 	scheme       *dcrypto.Scheme
 
 	localCache *lru.Cache[uint64, *types.BeaconEntry]
+	// store keeps the entries across restarts, it is optional
+	store *BeaconStore
 }
 
 func (db *DrandBeacon) IsChained() bool {
@@ -74,30 +83,32 @@ func (l *logger) AddCallerSkip(skip int) dlog.Logger {
 
 // NewDrandBeacon create new beacon client from config, genesis block time and block delay
 func NewDrandBeacon(genTimeStamp, interval uint64, config cfg.DrandConf) (*DrandBeacon, error) {
-	drandChain, err := dchain.InfoFromJSON(bytes.NewReader([]byte(config.ChainInfoJSON)))
+	drandChain, err := drandChainInfo(config)
 	if err != nil {
-		return nil, fmt.Errorf("unable to unmarshal drand chain info: %w", err)
+		return nil, err
 	}
 
 	var clients []dclient.Client
+	var endpoints []*endpoint
 	for _, url := range config.Servers {
 		hc, err := hclient.NewWithInfo(url, drandChain, nil)
 		if err != nil {
 			return nil, fmt.Errorf("could not create http drand client: %w", err)
 		}
 		hc.(DrandHTTPClient).SetUserAgent("drand-client-lotus/" + constants.BuildVersion)
-		clients = append(clients, hc)
-
+		e := newEndpoint(url, hc)
+		clients = append(clients, e)
+		endpoints = append(endpoints, e)
 	}
 
+	groupEndpoints(endpoints)
+
 	opts := []dclient.Option{
 		dclient.WithChainInfo(drandChain),
 		dclient.WithCacheSize(1024),
 		dclient.WithLogger(&logger{&log.SugaredLogger}),
 	}
 
-	log.Info("drand beacon without pubsub")
-
 	client, err := dclient.Wrap(clients, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("creating drand client: %v", err)
@@ -111,6 +122,8 @@ func NewDrandBeacon(genTimeStamp, interval uint64, config cfg.DrandConf) (*Drand
 	db := &DrandBeacon{
 		isChained:  config.IsChained,
 		client:     client,
+		endpoints:  endpoints,
+		chainHash:  drandChain.Hash(),
 		localCache: lc,
 	}
 
@@ -128,6 +141,55 @@ func NewDrandBeacon(genTimeStamp, interval uint64, config cfg.DrandConf) (*Drand
 	return db, nil
 }
 
+// drandChainInfo returns the chain info of the drand network in config. Info set in the config is
+// checked against the pinned chain hash, otherwise it is fetched from the servers, which must
+// advertise the pinned chain.
+func drandChainInfo(config cfg.DrandConf) (*dchain.Info, error) {
+	var chainHash []byte
+	if len(config.ChainHash) > 0 {
+		h, err := hex.DecodeString(config.ChainHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drand chain hash %s: %w", config.ChainHash, err)
+		}
+		chainHash = h
+	}
+
+	if len(config.ChainInfoJSON) > 0 {
+		drandChain, err := dchain.InfoFromJSON(bytes.NewReader([]byte(config.ChainInfoJSON)))
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal drand chain info: %w", err)
+		}
+		if chainHash != nil && !bytes.Equal(drandChain.Hash(), chainHash) {
+			return nil, fmt.Errorf("drand chain info hash %x doesn't match pinned chain hash %s", drandChain.Hash(), config.ChainHash)
+		}
+		return drandChain, nil
+	}
+
+	if chainHash == nil {
+		return nil, fmt.Errorf("drand config has neither chain info nor chain hash")
+	}
+
+	var errs []error
+	for _, url := range config.Servers {
+		// the client fetches the chain info and checks that it hashes to chainHash
+		hc, err := hclient.New(url, chainHash, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		drandChain, err := hc.Info(ctx)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+			continue
+		}
+		log.Infof("fetched drand chain info %s from %s", config.ChainHash, url)
+		return drandChain, nil
+	}
+	return nil, fmt.Errorf("fetching drand chain info %s: %w", config.ChainHash, errors.Join(errs...))
+}
+
 // Entry get a beacon value of specify block height,
 func (db *DrandBeacon) Entry(ctx context.Context, round uint64) <-chan Response {
 	out := make(chan Response, 1)
@@ -151,6 +213,8 @@ func (db *DrandBeacon) Entry(ctx context.Context, round uint64) <-chan Response
 		} else {
 			br.Entry.Round = resp.Round()
 			br.Entry.Data = resp.Signature()
+			// the drand client verifies the rounds it returns
+			db.cacheValue(br.Entry)
 		}
 		log.Infow("done fetching randomness", "round", round, "took", time.Since(start))
 		out <- br
@@ -162,13 +226,46 @@ func (db *DrandBeacon) Entry(ctx context.Context, round uint64) <-chan Response
 
 func (db *DrandBeacon) cacheValue(e types.BeaconEntry) {
 	db.localCache.Add(e.Round, &e)
+	if db.store != nil {
+		if err := db.store.Put(context.TODO(), e); err != nil {
+			log.Warnf("storing drand round %d: %s", e.Round, err)
+		}
+	}
 }
 
 func (db *DrandBeacon) getCachedValue(round uint64) *types.BeaconEntry {
-	v, _ := db.localCache.Get(round)
+	if v, ok := db.localCache.Get(round); ok {
+		return v
+	}
+	if db.store == nil {
+		return nil
+	}
+	v, err := db.store.Get(context.TODO(), round)
+	if err != nil {
+		log.Warnf("loading drand round %d: %s", round, err)
+		return nil
+	}
+	if v != nil {
+		db.localCache.Add(round, v)
+	}
 	return v
 }
 
+// UseStore keeps the verified entries in ds, so they don't have to be fetched or verified again
+// after a restart.
+func (db *DrandBeacon) UseStore(ds datastore.Batching) {
+	db.store = NewBeaconStore(ds, db.chainHash)
+}
+
+// PruneStore deletes the stored entries of the rounds before the one of epoch.
+func (db *DrandBeacon) PruneStore(ctx context.Context, epoch abi.ChainEpoch) (int, error) {
+	if db.store == nil || epoch <= 0 {
+		return 0, nil
+	}
+	// rounds have been mapped to epochs the same way since nv16
+	return db.store.Prune(ctx, db.MaxBeaconRoundForEpoch(network.Version16, epoch))
+}
+
 func (db *DrandBeacon) VerifyEntry(entry types.BeaconEntry, prevEntrySig []byte) error {
 	if be := db.getCachedValue(entry.Round); be != nil {
 		if !bytes.Equal(entry.Data, be.Data) {
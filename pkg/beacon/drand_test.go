@@ -50,3 +50,16 @@ func TestQuicknetIsChained(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, db.IsChained())
 }
+
+func TestDrandChainHashPinned(t *testing.T) {
+	tf.UnitTest(t)
+	for enum, drandCfg := range config.DrandConfigs {
+		_, err := drandChainInfo(drandCfg)
+		assert.NoError(t, err, "drand config %d", enum)
+	}
+
+	drandCfg := config.DrandConfigs[config.DrandMainnet]
+	drandCfg.ChainHash = config.DrandConfigs[config.DrandQuicknet].ChainHash
+	_, err := NewDrandBeacon(uint64(1652222222), config.NewDefaultConfig().NetworkParams.BlockDelay, drandCfg)
+	assert.Error(t, err)
+}
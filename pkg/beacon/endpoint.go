@@ -0,0 +1,155 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	dclient "github.com/drand/drand/client"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+const (
+	endpointTimeout = 10 * time.Second
+	// endpointBackoff is how long an endpoint is skipped after a failure, it doubles with every
+	// consecutive failure up to endpointMaxBackoff
+	endpointBackoff    = 5 * time.Second
+	endpointMaxBackoff = 5 * time.Minute
+	// speedTestRound is the round the optimizing drand client fetches to measure the speed of the
+	// servers, those requests don't count towards the health of a server
+	speedTestRound = 1
+)
+
+// endpoint wraps the client of a drand server to track its health. After a failed or timed out
+// request the server is skipped for a while, so the other servers answer instead. When every
+// server of the group is skipped, the one whose backoff ends first is still asked.
+type endpoint struct {
+	dclient.Client
+	url   string
+	group []*endpoint
+
+	lk           sync.Mutex
+	successes    uint64
+	failures     uint64
+	consecutive  int
+	lastErr      error
+	lastSuccess  time.Time
+	latency      time.Duration
+	backoffUntil time.Time
+}
+
+func newEndpoint(url string, c dclient.Client) *endpoint {
+	e := &endpoint{Client: c, url: url}
+	e.group = []*endpoint{e}
+	return e
+}
+
+// groupEndpoints makes the endpoints of the servers of a drand network aware of each other.
+func groupEndpoints(endpoints []*endpoint) {
+	for _, e := range endpoints {
+		e.group = endpoints
+	}
+}
+
+func (e *endpoint) backoff() time.Time {
+	e.lk.Lock()
+	defer e.lk.Unlock()
+	return e.backoffUntil
+}
+
+// skipped tells whether the endpoint is backing off while another endpoint of the group is
+// available sooner.
+func (e *endpoint) skipped(now time.Time) (time.Time, bool) {
+	until := e.backoff()
+	if !now.Before(until) {
+		return until, false
+	}
+	for _, other := range e.group {
+		if other == e {
+			continue
+		}
+		otherUntil := other.backoff()
+		if otherUntil.Before(until) || (otherUntil.Equal(until) && other.url < e.url) {
+			return until, true
+		}
+	}
+	return until, false
+}
+
+// Get fetches round unless the endpoint is backing off.
+func (e *endpoint) Get(ctx context.Context, round uint64) (dclient.Result, error) {
+	if until, skip := e.skipped(time.Now()); skip {
+		return nil, fmt.Errorf("drand endpoint %s skipped until %s after failures", e.url, until.Format(time.RFC3339))
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, endpointTimeout)
+	defer cancel()
+	start := time.Now()
+	res, err := e.Client.Get(reqCtx, round)
+	// a request canceled by the caller, e.g. because another endpoint answered first, says
+	// nothing about the health of the endpoint, nor do the speed tests of the drand client
+	if ctx.Err() == nil && round != speedTestRound {
+		e.record(time.Since(start), err)
+	}
+	return res, err
+}
+
+func (e *endpoint) record(took time.Duration, err error) {
+	e.lk.Lock()
+	defer e.lk.Unlock()
+
+	if err == nil {
+		e.successes++
+		e.consecutive = 0
+		e.lastSuccess = time.Now()
+		e.latency = took
+		e.backoffUntil = time.Time{}
+		return
+	}
+
+	e.failures++
+	e.consecutive++
+	e.lastErr = err
+	backoff := endpointBackoff << (e.consecutive - 1)
+	if backoff > endpointMaxBackoff || backoff <= 0 {
+		backoff = endpointMaxBackoff
+	}
+	e.backoffUntil = time.Now().Add(backoff)
+	log.Warnf("drand endpoint %s failed %d times in a row, skipping it for %s: %s", e.url, e.consecutive, backoff, err)
+}
+
+func (e *endpoint) status() types.DrandEndpointStatus {
+	e.lk.Lock()
+	defer e.lk.Unlock()
+
+	st := types.DrandEndpointStatus{
+		URL:                 e.url,
+		Healthy:             e.consecutive == 0,
+		Successes:           e.successes,
+		Failures:            e.failures,
+		ConsecutiveFailures: e.consecutive,
+		LastSuccess:         e.lastSuccess,
+		Latency:             e.latency,
+	}
+	if e.lastErr != nil {
+		st.LastError = e.lastErr.Error()
+	}
+	if time.Now().Before(e.backoffUntil) {
+		st.SkippedUntil = e.backoffUntil
+	}
+	return st
+}
+
+// Status reports the health of the drand servers of the beacon.
+func (db *DrandBeacon) Status() types.DrandStatus {
+	st := types.DrandStatus{
+		ChainHash: fmt.Sprintf("%x", db.chainHash),
+		Endpoints: make([]types.DrandEndpointStatus, 0, len(db.endpoints)),
+	}
+	for _, e := range db.endpoints {
+		st.Endpoints = append(st.Endpoints, e.status())
+	}
+	return st
+}
@@ -0,0 +1,120 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dclient "github.com/drand/drand/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+type fakeDrandClient struct {
+	dclient.Client
+	calls int
+	err   error
+}
+
+func (c *fakeDrandClient) Get(ctx context.Context, round uint64) (dclient.Result, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &dclient.RandomData{Rnd: round}, nil
+}
+
+func TestEndpointBackoff(t *testing.T) {
+	tf.UnitTest(t)
+	fc := &fakeDrandClient{err: errors.New("down")}
+	e := newEndpoint("http://drand", fc)
+	groupEndpoints([]*endpoint{e, newEndpoint("http://other", &fakeDrandClient{})})
+
+	_, err := e.Get(context.Background(), 10)
+	require.Error(t, err)
+	st := e.status()
+	assert.False(t, st.Healthy)
+	assert.Equal(t, 1, st.ConsecutiveFailures)
+	assert.Equal(t, "down", st.LastError)
+	assert.True(t, st.SkippedUntil.After(time.Now()))
+
+	// skipped while backing off and another server is available, the server isn't asked
+	_, err = e.Get(context.Background(), 10)
+	require.Error(t, err)
+	assert.Equal(t, 1, fc.calls)
+
+	// a failure of a request canceled by the caller isn't the fault of the server
+	e.backoffUntil = time.Time{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = e.Get(ctx, 10)
+	require.Error(t, err)
+	assert.Equal(t, uint64(1), e.status().Failures)
+
+	// the backoff doubles with every consecutive failure
+	_, err = e.Get(context.Background(), 10)
+	require.Error(t, err)
+	assert.Greater(t, time.Until(e.status().SkippedUntil), endpointBackoff)
+
+	fc.err = nil
+	e.backoffUntil = time.Time{}
+	res, err := e.Get(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Round())
+	st = e.status()
+	assert.True(t, st.Healthy)
+	assert.Equal(t, uint64(1), st.Successes)
+	assert.Equal(t, uint64(2), st.Failures)
+	assert.True(t, st.SkippedUntil.IsZero())
+}
+
+func TestEndpointAllBackingOff(t *testing.T) {
+	tf.UnitTest(t)
+	fc1 := &fakeDrandClient{err: errors.New("down")}
+	fc2 := &fakeDrandClient{err: errors.New("down")}
+	e1, e2 := newEndpoint("http://drand1", fc1), newEndpoint("http://drand2", fc2)
+	groupEndpoints([]*endpoint{e1, e2})
+
+	_, err := e1.Get(context.Background(), 2)
+	require.Error(t, err)
+	_, err = e2.Get(context.Background(), 2)
+	require.Error(t, err)
+	require.True(t, e1.backoff().Before(e2.backoff()))
+
+	// every server is backing off, the one whose backoff ends first is still asked
+	fc1.err = nil
+	_, err = e2.Get(context.Background(), 3)
+	require.Error(t, err)
+	assert.Equal(t, 1, fc2.calls)
+	res, err := e1.Get(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), res.Round())
+	assert.Equal(t, 2, fc1.calls)
+	assert.True(t, e1.status().Healthy)
+
+	// a single server is always asked
+	fc := &fakeDrandClient{err: errors.New("down")}
+	e := newEndpoint("http://drand", fc)
+	_, err = e.Get(context.Background(), 2)
+	require.Error(t, err)
+	_, err = e.Get(context.Background(), 2)
+	require.Error(t, err)
+	assert.Equal(t, 2, fc.calls)
+}
+
+func TestEndpointSpeedTest(t *testing.T) {
+	tf.UnitTest(t)
+	fc := &fakeDrandClient{err: errors.New("down")}
+	e := newEndpoint("http://drand", fc)
+
+	// the speed tests of the drand client don't make the server back off
+	_, err := e.Get(context.Background(), speedTestRound)
+	require.Error(t, err)
+	st := e.status()
+	assert.True(t, st.Healthy)
+	assert.Equal(t, uint64(0), st.Failures)
+	assert.True(t, st.SkippedUntil.IsZero())
+}
@@ -0,0 +1,142 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	dchain "github.com/drand/drand/chain"
+	"github.com/drand/drand/protobuf/drand"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// gossipMaxFutureRounds is how many rounds past the one due at the local time a relayed round may
+// be, to allow for some clock skew between the node and the drand network.
+const gossipMaxFutureRounds = 1
+
+// DrandGossip subscribes to the pubsub topic the drand relays publish rounds on, and feeds the
+// rounds which verify into the cache of a DrandBeacon, so Entry rarely has to ask the drand
+// servers for a round.
+type DrandGossip struct {
+	ps *pubsub.PubSub
+	db *DrandBeacon
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewDrandGossip creates the gossip ingestion of db, it does nothing until started.
+func NewDrandGossip(ps *pubsub.PubSub, db *DrandBeacon) *DrandGossip {
+	return &DrandGossip{ps: ps, db: db}
+}
+
+// Topic is the pubsub topic of the drand network of the beacon.
+func (g *DrandGossip) Topic() string {
+	return "/drand/pubsub/v0.0.0/" + hex.EncodeToString(g.db.chainHash)
+}
+
+// Start registers the validator of the topic and subscribes to it.
+func (g *DrandGossip) Start(ctx context.Context) error {
+	topic := g.Topic()
+	if err := g.ps.RegisterTopicValidator(topic, g.db.validateGossip); err != nil {
+		return fmt.Errorf("registering validator of %s: %w", topic, err)
+	}
+	t, err := g.ps.Join(topic)
+	if err != nil {
+		_ = g.ps.UnregisterTopicValidator(topic)
+		return fmt.Errorf("joining %s: %w", topic, err)
+	}
+	sub, err := t.Subscribe()
+	if err != nil {
+		_ = t.Close()
+		_ = g.ps.UnregisterTopicValidator(topic)
+		return fmt.Errorf("subscribing %s: %w", topic, err)
+	}
+
+	ctx, g.cancel = context.WithCancel(ctx)
+	g.done = make(chan struct{})
+	go g.loop(ctx, t, sub)
+	log.Infof("ingesting drand rounds from %s", topic)
+	return nil
+}
+
+// Stop leaves the topic.
+func (g *DrandGossip) Stop() {
+	if g.cancel == nil {
+		return
+	}
+	g.cancel()
+	<-g.done
+}
+
+func (g *DrandGossip) loop(ctx context.Context, t *pubsub.Topic, sub *pubsub.Subscription) {
+	defer close(g.done)
+	defer func() {
+		sub.Cancel()
+		_ = t.Close()
+		_ = g.ps.UnregisterTopicValidator(t.String())
+	}()
+
+	for {
+		msg, err := sub.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Warnf("reading drand gossip: %s", err)
+			}
+			return
+		}
+		// the validator only accepts rounds which verify, and leaves the entry on the message
+		if e, ok := msg.ValidatorData.(*types.BeaconEntry); ok {
+			g.db.cacheValue(*e)
+		}
+	}
+}
+
+// validateGossip rejects rounds which don't decode or verify, and ignores the ones the node
+// already has or which are too far ahead of the local time to have been produced yet.
+func (db *DrandBeacon) validateGossip(ctx context.Context, _ peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	var rand drand.PublicRandResponse
+	if err := proto.Unmarshal(msg.Data, &rand); err != nil {
+		return pubsub.ValidationReject
+	}
+	if rand.Round == 0 {
+		return pubsub.ValidationReject
+	}
+	if rand.Round > db.currentRound(time.Now())+gossipMaxFutureRounds {
+		return pubsub.ValidationIgnore
+	}
+
+	if be := db.getCachedValue(rand.Round); be != nil {
+		if bytes.Equal(be.Data, rand.Signature) {
+			return pubsub.ValidationIgnore
+		}
+		return pubsub.ValidationReject
+	}
+
+	b := &dchain.Beacon{
+		PreviousSig: rand.PreviousSignature,
+		Round:       rand.Round,
+		Signature:   rand.Signature,
+	}
+	if err := db.scheme.VerifyBeacon(b, db.pubkey); err != nil {
+		log.Debugf("rejecting drand round %d from gossip: %s", rand.Round, err)
+		return pubsub.ValidationReject
+	}
+	msg.ValidatorData = &types.BeaconEntry{Round: rand.Round, Data: rand.Signature}
+	return pubsub.ValidationAccept
+}
+
+// currentRound is the last round the drand network produced by now.
+func (db *DrandBeacon) currentRound(now time.Time) uint64 {
+	ts := now.Unix()
+	if ts < int64(db.drandGenTime) {
+		return 0
+	}
+	return uint64(ts-int64(db.drandGenTime))/uint64(db.interval.Seconds()) + 1
+}
@@ -0,0 +1,46 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/drand/drand/protobuf/drand"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/venus/pkg/config"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestValidateGossip(t *testing.T) {
+	tf.UnitTest(t)
+	db, err := NewDrandBeacon(uint64(1700000000), config.NewDefaultConfig().NetworkParams.BlockDelay, config.DrandConfigs[config.DrandQuicknet])
+	require.NoError(t, err)
+
+	validate := func(data []byte) pubsub.ValidationResult {
+		return db.validateGossip(context.Background(), "", &pubsub.Message{Message: &pb.Message{Data: data}})
+	}
+	marshal := func(round uint64, sig []byte) []byte {
+		data, err := proto.Marshal(&drand.PublicRandResponse{Round: round, Signature: sig})
+		require.NoError(t, err)
+		return data
+	}
+
+	assert.Equal(t, pubsub.ValidationReject, validate([]byte("not a round")))
+	assert.Equal(t, pubsub.ValidationReject, validate(marshal(0, []byte("sig"))))
+
+	current := db.currentRound(time.Now())
+	assert.Equal(t, pubsub.ValidationIgnore, validate(marshal(current+10, []byte("sig"))))
+	assert.Equal(t, pubsub.ValidationReject, validate(marshal(current-1, []byte("sig"))))
+
+	db.cacheValue(types.BeaconEntry{Round: current - 1, Data: []byte("sig")})
+	assert.Equal(t, pubsub.ValidationIgnore, validate(marshal(current-1, []byte("sig"))))
+	assert.Equal(t, pubsub.ValidationReject, validate(marshal(current-1, []byte("other"))))
+
+	assert.Equal(t, "/drand/pubsub/v0.0.0/"+config.DrandConfigs[config.DrandQuicknet].ChainHash, NewDrandGossip(nil, db).Topic())
+}
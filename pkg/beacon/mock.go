@@ -65,6 +65,10 @@ func (mb *mockBeacon) VerifyEntry(from types.BeaconEntry, _prevEntrySig []byte)
 	return nil
 }
 
+func (mb *mockBeacon) VerifyEntries(entries []types.BeaconEntry, prevEntrySig []byte) error {
+	return verifyEntriesOneByOne(mb, entries, prevEntrySig)
+}
+
 func (mb *mockBeacon) MaxBeaconRoundForEpoch(nv network.Version, epoch abi.ChainEpoch) uint64 {
 	// offset for better testing
 	return uint64(epoch + 100)
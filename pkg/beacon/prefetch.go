@@ -0,0 +1,76 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/network"
+
+	"github.com/filecoin-project/venus/pkg/constants"
+)
+
+// prefetchDelay leaves the drand network some time to publish the round due at the start of an
+// epoch before fetching it.
+const prefetchDelay = time.Second
+
+// StartPrefetch fetches, at the start of every epoch, the rounds the next epochs up to the given
+// number need which the drand network already produced, so block production finds them in the
+// cache instead of waiting for the drand servers. Zero epochs disables it. It stops with ctx.
+func (bs Schedule) StartPrefetch(ctx context.Context, epochs abi.ChainEpoch) {
+	if epochs <= 0 {
+		return
+	}
+	// every drand beacon of the schedule shares the genesis time and block delay of the chain
+	var clock *DrandBeacon
+	for _, bp := range bs {
+		if db, ok := bp.Beacon.(*DrandBeacon); ok {
+			clock = db
+			break
+		}
+	}
+	if clock == nil {
+		return
+	}
+
+	go func() {
+		for {
+			now := constants.Clock.Now()
+			epoch := clock.epochAt(now)
+			for e := epoch; e <= epoch+epochs; e++ {
+				if db, ok := bs.BeaconForEpoch(e).(*DrandBeacon); ok {
+					db.prefetch(ctx, e, now)
+				}
+			}
+
+			next := time.Unix(int64(clock.filGenTime+uint64(epoch+1)*clock.filRoundTime), 0).Add(prefetchDelay)
+			select {
+			case <-constants.Clock.After(constants.Clock.Until(next)):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// epochAt is the epoch of the chain at t by the wall clock.
+func (db *DrandBeacon) epochAt(t time.Time) abi.ChainEpoch {
+	ts := uint64(t.Unix())
+	if ts < db.filGenTime || db.filRoundTime == 0 {
+		return 0
+	}
+	return abi.ChainEpoch((ts - db.filGenTime) / db.filRoundTime)
+}
+
+// prefetch fetches the round of epoch unless it is cached or not produced yet at now.
+func (db *DrandBeacon) prefetch(ctx context.Context, epoch abi.ChainEpoch, now time.Time) {
+	// rounds have been mapped to epochs the same way since nv16
+	round := db.MaxBeaconRoundForEpoch(network.Version16, epoch)
+	if round > db.currentRound(now) || db.getCachedValue(round) != nil {
+		return
+	}
+	// Entry caches the round it fetches
+	if resp := <-db.Entry(ctx, round); resp.Err != nil {
+		log.Debugf("prefetching drand round %d for epoch %d: %s", round, epoch, resp.Err)
+	}
+}
@@ -0,0 +1,34 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/config"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+func TestPrefetchClock(t *testing.T) {
+	tf.UnitTest(t)
+	genesis := uint64(1700000000)
+	db, err := NewDrandBeacon(genesis, 30, config.DrandConfigs[config.DrandQuicknet])
+	require.NoError(t, err)
+
+	assert.Equal(t, abi.ChainEpoch(0), db.epochAt(time.Unix(int64(genesis)-10, 0)))
+	assert.Equal(t, abi.ChainEpoch(2), db.epochAt(time.Unix(int64(genesis)+61, 0)))
+
+	// the round of the next epoch is out at the start of the current one, the one after isn't
+	now := time.Unix(int64(genesis)+60, 0)
+	assert.LessOrEqual(t, db.MaxBeaconRoundForEpoch(network.Version16, 3), db.currentRound(now))
+	assert.Greater(t, db.MaxBeaconRoundForEpoch(network.Version16, 4), db.currentRound(now))
+
+	// nothing to fetch for rounds which aren't out yet
+	db.prefetch(context.Background(), 10, now)
+	assert.Nil(t, db.getCachedValue(db.MaxBeaconRoundForEpoch(network.Version16, 10)))
+}
@@ -1,10 +1,13 @@
 package beacon
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-datastore"
 
 	cfg "github.com/filecoin-project/venus/pkg/config"
 )
@@ -22,6 +25,34 @@ func (bs Schedule) BeaconForEpoch(e abi.ChainEpoch) RandomBeacon {
 	return bs[0].Beacon
 }
 
+// UseStore keeps the entries of the drand beacons of the schedule in ds.
+func (bs Schedule) UseStore(ds datastore.Batching) {
+	for _, bp := range bs {
+		if db, ok := bp.Beacon.(*DrandBeacon); ok {
+			db.UseStore(ds)
+		}
+	}
+}
+
+// PruneStore deletes the stored entries of the drand beacons of the schedule for the rounds
+// before the one of epoch.
+func (bs Schedule) PruneStore(ctx context.Context, epoch abi.ChainEpoch) error {
+	for _, bp := range bs {
+		db, ok := bp.Beacon.(*DrandBeacon)
+		if !ok {
+			continue
+		}
+		n, err := db.PruneStore(ctx, epoch)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			log.Debugf("pruned %d drand rounds before epoch %d", n, epoch)
+		}
+	}
+	return nil
+}
+
 // DrandConfigSchedule create new beacon schedule , used to select beacon server at specify chain height
 func DrandConfigSchedule(genTimeStamp uint64, blockDelay uint64, drandSchedule map[abi.ChainEpoch]cfg.DrandEnum) (Schedule, error) {
 	shd := Schedule{}
@@ -41,3 +72,68 @@ func DrandConfigSchedule(genTimeStamp uint64, blockDelay uint64, drandSchedule m
 	log.Infof("Schedule: %v", shd)
 	return shd, nil
 }
+
+// ConfigSchedule creates the beacon schedule overriding the one of the network from the repo
+// config, which the caller only allows on devnets and test networks. Drand beacons are checked against the genesis timestamp and the block delay, they must
+// have produced a round by the first epoch they serve and produce at least one round per epoch.
+func ConfigSchedule(genTimeStamp uint64, blockDelay uint64, points []cfg.BeaconPointConfig) (Schedule, error) {
+	shd := Schedule{}
+
+	for _, point := range points {
+		set := 0
+		for _, ok := range []bool{point.Mock, len(point.Network) > 0, point.Drand != nil} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("beacon at %d must set exactly one of mock, network and drand", point.Start)
+		}
+
+		if point.Mock {
+			shd = append(shd, BeaconPoint{Start: point.Start, Beacon: NewMockBeacon(time.Duration(blockDelay) * time.Second)})
+			continue
+		}
+
+		drandCfg := point.Drand
+		if len(point.Network) > 0 {
+			enum, ok := cfg.DrandNetworks[point.Network]
+			if !ok {
+				return nil, fmt.Errorf("beacon at %d uses unknown drand network %s", point.Start, point.Network)
+			}
+			conf := cfg.DrandConfigs[enum]
+			drandCfg = &conf
+		}
+		bc, err := NewDrandBeacon(genTimeStamp, blockDelay, *drandCfg)
+		if err != nil {
+			return nil, fmt.Errorf("creating drand beacon at %d: %v", point.Start, err)
+		}
+
+		start := point.Start
+		if start < 0 {
+			start = 0
+		}
+		if firstEpoch := genTimeStamp + uint64(start)*blockDelay; bc.drandGenTime > firstEpoch {
+			return nil, fmt.Errorf("drand beacon at %d starts at %d, after its first epoch at %d", point.Start, bc.drandGenTime, firstEpoch)
+		}
+		if bc.interval > time.Duration(blockDelay)*time.Second {
+			return nil, fmt.Errorf("drand beacon at %d has a period of %s, longer than the block delay of %ds", point.Start, bc.interval, blockDelay)
+		}
+		shd = append(shd, BeaconPoint{Start: point.Start, Beacon: bc})
+	}
+
+	sort.Slice(shd, func(i, j int) bool {
+		return shd[i].Start < shd[j].Start
+	})
+	if len(shd) == 0 || shd[0].Start > 0 {
+		return nil, fmt.Errorf("beacon schedule must cover epoch 0")
+	}
+	for i := 1; i < len(shd); i++ {
+		if shd[i].Start == shd[i-1].Start {
+			return nil, fmt.Errorf("beacon schedule has two beacons starting at %d", shd[i].Start)
+		}
+	}
+
+	log.Infof("Schedule from config: %v", shd)
+	return shd, nil
+}
@@ -0,0 +1,50 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/config"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+func TestConfigSchedule(t *testing.T) {
+	tf.UnitTest(t)
+	quicknet := config.DrandConfigs[config.DrandQuicknet]
+	genesis := uint64(1700000000)
+
+	shd, err := ConfigSchedule(genesis, 30, []config.BeaconPointConfig{
+		{Start: 100, Network: "quicknet"},
+		{Start: 0, Mock: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, shd, 2)
+	assert.Equal(t, abi.ChainEpoch(0), shd[0].Start)
+	assert.IsType(t, &mockBeacon{}, shd.BeaconForEpoch(99))
+	assert.IsType(t, &DrandBeacon{}, shd.BeaconForEpoch(100))
+
+	_, err = ConfigSchedule(genesis, 30, []config.BeaconPointConfig{{Start: 0, Drand: &quicknet}})
+	assert.NoError(t, err)
+
+	for name, points := range map[string][]config.BeaconPointConfig{
+		"empty":           {},
+		"not from 0":      {{Start: 10, Mock: true}},
+		"duplicate start": {{Start: 0, Mock: true}, {Start: 0, Network: "quicknet"}},
+		"none set":        {{Start: 0}},
+		"two set":         {{Start: 0, Mock: true, Network: "quicknet"}},
+		"unknown network": {{Start: 0, Network: "nope"}},
+	} {
+		_, err := ConfigSchedule(genesis, 30, points)
+		assert.Error(t, err, name)
+	}
+
+	// the chain starts before the first quicknet round
+	_, err = ConfigSchedule(1600000000, 30, []config.BeaconPointConfig{{Start: 0, Network: "quicknet"}})
+	assert.Error(t, err)
+	// quicknet rounds are 3s apart, a 1s block delay would leave epochs without a round
+	_, err = ConfigSchedule(genesis, 1, []config.BeaconPointConfig{{Start: 0, Network: "quicknet"}})
+	assert.Error(t, err)
+}
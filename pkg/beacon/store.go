@@ -0,0 +1,81 @@
+package beacon
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// BeaconStore keeps the verified entries of a drand network in the datastore, by round, so they
+// survive restarts.
+type BeaconStore struct { //nolint
+	ds     datastore.Batching
+	prefix datastore.Key
+}
+
+// NewBeaconStore creates the store of the entries of the drand network with chainHash.
+func NewBeaconStore(ds datastore.Batching, chainHash []byte) *BeaconStore {
+	return &BeaconStore{
+		ds:     ds,
+		prefix: datastore.NewKey("/beacon/" + hex.EncodeToString(chainHash)),
+	}
+}
+
+func (s *BeaconStore) key(round uint64) datastore.Key {
+	// zero padded so the keys sort by round
+	return s.prefix.ChildString(fmt.Sprintf("%020d", round))
+}
+
+// Put stores e.
+func (s *BeaconStore) Put(ctx context.Context, e types.BeaconEntry) error {
+	return s.ds.Put(ctx, s.key(e.Round), e.Data)
+}
+
+// Get returns the entry of round, or nil when it isn't stored.
+func (s *BeaconStore) Get(ctx context.Context, round uint64) (*types.BeaconEntry, error) {
+	data, err := s.ds.Get(ctx, s.key(round))
+	if err != nil {
+		if errors.Is(err, datastore.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &types.BeaconEntry{Round: round, Data: data}, nil
+}
+
+// Prune deletes the entries of the rounds before round, it returns how many were deleted.
+func (s *BeaconStore) Prune(ctx context.Context, round uint64) (int, error) {
+	res, err := s.ds.Query(ctx, query.Query{Prefix: s.prefix.String(), KeysOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close() //nolint:errcheck
+
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return 0, err
+	}
+	pruned := 0
+	for r := range res.Next() {
+		if r.Error != nil {
+			return 0, r.Error
+		}
+		k := datastore.NewKey(r.Key)
+		n, err := strconv.ParseUint(k.BaseNamespace(), 10, 64)
+		if err != nil || n >= round {
+			continue
+		}
+		if err := batch.Delete(ctx, k); err != nil {
+			return 0, err
+		}
+		pruned++
+	}
+	return pruned, batch.Commit(ctx)
+}
@@ -0,0 +1,48 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestBeaconStore(t *testing.T) {
+	tf.UnitTest(t)
+	ctx := context.Background()
+	ds := dssync.MutexWrap(datastore.NewMapDatastore())
+	s := NewBeaconStore(ds, []byte{1, 2})
+	other := NewBeaconStore(ds, []byte{3, 4})
+
+	for _, round := range []uint64{5, 10, 100, 1000} {
+		require.NoError(t, s.Put(ctx, types.BeaconEntry{Round: round, Data: []byte{byte(round)}}))
+	}
+	require.NoError(t, other.Put(ctx, types.BeaconEntry{Round: 1, Data: []byte{1}}))
+
+	e, err := s.Get(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &types.BeaconEntry{Round: 10, Data: []byte{10}}, e)
+	e, err = s.Get(ctx, 11)
+	require.NoError(t, err)
+	assert.Nil(t, e)
+
+	pruned, err := s.Prune(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pruned)
+	for round, kept := range map[uint64]bool{5: false, 10: false, 100: true, 1000: true} {
+		e, err := s.Get(ctx, round)
+		require.NoError(t, err)
+		assert.Equal(t, kept, e != nil, "round %d", round)
+	}
+
+	// the entries of other drand networks are left alone
+	e, err = other.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.NotNil(t, e)
+}
@@ -11,6 +11,9 @@ import (
 	cbor2 "github.com/filecoin-project/go-state-types/cbor"
 	"github.com/filecoin-project/specs-actors/actors/util/adt"
 
+	"github.com/hashicorp/golang-lru/arc/v2"
+	"github.com/ipfs-force-community/metrics"
+
 	blockstore "github.com/ipfs/boxo/blockstore"
 	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
@@ -48,20 +51,65 @@ type MessageWriter interface {
 	StoreTxMeta(context.Context, types.MessageRoot) (cid.Cid, error)
 }
 
+// DefaultMessageCacheSize is the number of recently loaded block message lists kept in memory.
+const DefaultMessageCacheSize = 2048
+
+type messageStoreOptions struct {
+	messageCacheSize int
+}
+
+// MessageStoreOption configures a MessageStore created by NewMessageStore.
+type MessageStoreOption func(*messageStoreOptions)
+
+// WithMessageCacheSize sets the number of block message lists kept in memory, a size which isn't
+// positive keeps DefaultMessageCacheSize.
+func WithMessageCacheSize(size int) MessageStoreOption {
+	return func(opts *messageStoreOptions) {
+		if size > 0 {
+			opts.messageCacheSize = size
+		}
+	}
+}
+
+var (
+	msgCacheHit  = metrics.NewCounter("chain/message_cache_hit", "Number of block message lists served from the message cache")
+	msgCacheMiss = metrics.NewCounter("chain/message_cache_miss", "Number of block message lists loaded from the blockstore")
+)
+
+// metaMessages are the messages of a block, indexed by the cid of its message meta.
+type metaMessages struct {
+	secp []*types.SignedMessage
+	bls  []*types.Message
+}
+
 // MessageStore stores and loads collections of signed messages and receipts.
 type MessageStore struct {
 	bs    blockstoreutil.Blockstore
 	fkCfg *config.ForkUpgradeConfig
+
+	msgCache *arc.ARCCache[cid.Cid, metaMessages]
 }
 
 // NewMessageStore creates and returns a new store
-func NewMessageStore(bs blockstoreutil.Blockstore, fkCfg *config.ForkUpgradeConfig) *MessageStore {
-	return &MessageStore{bs: bs, fkCfg: fkCfg}
+func NewMessageStore(bs blockstoreutil.Blockstore, fkCfg *config.ForkUpgradeConfig, opts ...MessageStoreOption) *MessageStore {
+	options := messageStoreOptions{messageCacheSize: DefaultMessageCacheSize}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	msgCache, _ := arc.NewARC[cid.Cid, metaMessages](options.messageCacheSize)
+	return &MessageStore{bs: bs, fkCfg: fkCfg, msgCache: msgCache}
 }
 
 // LoadMetaMessages loads the signed messages in the collection with cid c from ipld
 // storage.
 func (ms *MessageStore) LoadMetaMessages(ctx context.Context, metaCid cid.Cid) ([]*types.SignedMessage, []*types.Message, error) {
+	if cached, has := ms.msgCache.Get(metaCid); has {
+		msgCacheHit.Tick(ctx)
+		// capped so that appending to the result never writes into the cached arrays
+		return cached.secp[:len(cached.secp):len(cached.secp)], cached.bls[:len(cached.bls):len(cached.bls)], nil
+	}
+	msgCacheMiss.Tick(ctx)
+
 	// load txmeta
 	meta, err := ms.LoadTxMeta(ctx, metaCid)
 	if err != nil {
@@ -90,7 +138,8 @@ func (ms *MessageStore) LoadMetaMessages(ctx context.Context, metaCid cid.Cid) (
 		return nil, nil, err
 	}
 
-	return secpMsgs, blsMsgs, nil
+	ms.msgCache.Add(metaCid, metaMessages{secp: secpMsgs, bls: blsMsgs})
+	return secpMsgs[:len(secpMsgs):len(secpMsgs)], blsMsgs[:len(blsMsgs):len(blsMsgs)], nil
 }
 
 // ReadMsgMetaCids load messager from message meta cid
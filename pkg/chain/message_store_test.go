@@ -139,6 +139,35 @@ func TestMessageStoreMessagesHappy(t *testing.T) {
 	}
 }
 
+func TestMessageStoreMessagesCached(t *testing.T) {
+	testflags.UnitTest(t)
+	ctx := context.Background()
+	keys := testhelpers.MustGenerateKeyInfo(1, 42)
+	mm := testhelpers.NewMessageMaker(t, keys)
+	alice := mm.Addresses()[0]
+
+	signedMsgs := []*types.SignedMessage{mm.NewSignedMessage(alice, 0), mm.NewSignedMessage(alice, 1)}
+	unsignedMsgs := []*types.Message{mm.NewUnsignedMessage(alice, 2)}
+
+	bs := blockstoreutil.Adapt(blockstore.NewBlockstore(datastore.NewMapDatastore()))
+	ms := chain.NewMessageStore(bs, config.DefaultForkUpgradeParam)
+	msgsCid, err := ms.StoreMessages(ctx, signedMsgs, unsignedMsgs)
+	require.NoError(t, err)
+
+	secp, bls, err := ms.LoadMetaMessages(ctx, msgsCid)
+	require.NoError(t, err)
+	// appending to a result must not change what later callers get
+	_ = append(secp, mm.NewSignedMessage(alice, 3))
+	_ = append(bls, mm.NewUnsignedMessage(alice, 4))
+
+	// served from the cache once the blocks are gone
+	require.NoError(t, bs.DeleteBlock(ctx, msgsCid))
+	secp, bls, err = ms.LoadMetaMessages(ctx, msgsCid)
+	require.NoError(t, err)
+	assert.Equal(t, signedMsgs, secp)
+	assert.Equal(t, unsignedMsgs, bls)
+}
+
 func TestMessageStoreReceiptsHappy(t *testing.T) {
 	ctx := context.Background()
 	mr := testhelpers.NewReceiptMaker()
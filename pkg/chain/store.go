@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/golang-lru/arc/v2"
+	"github.com/ipfs-force-community/metrics"
 	"github.com/ipld/go-car"
 	carutil "github.com/ipld/go-car/util"
 	carv2 "github.com/ipld/go-car/v2"
@@ -70,7 +71,46 @@ type loadTipSetFunc func(context.Context, types.TipSetKey) (*types.TipSet, error
 // ReorgNotifee represents a callback that gets called upon reorgs.
 type ReorgNotifee func(rev, app []*types.TipSet) error
 
-var DefaultTipsetLruCacheSize = 10000
+// DefaultTipsetLruCacheSize is the number of recently loaded tipsets kept in memory.
+const DefaultTipsetLruCacheSize = 10000
+
+// DefaultBlockHeaderCacheSize is the number of recently loaded block headers kept in memory.
+const DefaultBlockHeaderCacheSize = 4096
+
+type storeOptions struct {
+	tipsetCacheSize      int
+	blockHeaderCacheSize int
+}
+
+// StoreOption configures a Store created by NewStore.
+type StoreOption func(*storeOptions)
+
+// WithTipSetCacheSize sets the number of tipsets kept in memory, a size which isn't positive
+// keeps DefaultTipsetLruCacheSize.
+func WithTipSetCacheSize(size int) StoreOption {
+	return func(opts *storeOptions) {
+		if size > 0 {
+			opts.tipsetCacheSize = size
+		}
+	}
+}
+
+// WithBlockHeaderCacheSize sets the number of block headers kept in memory, a size which isn't
+// positive keeps DefaultBlockHeaderCacheSize.
+func WithBlockHeaderCacheSize(size int) StoreOption {
+	return func(opts *storeOptions) {
+		if size > 0 {
+			opts.blockHeaderCacheSize = size
+		}
+	}
+}
+
+var (
+	tsCacheHit      = metrics.NewCounter("chain/tipset_cache_hit", "Number of tipsets served from the tipset cache")
+	tsCacheMiss     = metrics.NewCounter("chain/tipset_cache_miss", "Number of tipsets loaded from the blockstore")
+	headerCacheHit  = metrics.NewCounter("chain/header_cache_hit", "Number of block headers served from the header cache")
+	headerCacheMiss = metrics.NewCounter("chain/header_cache_miss", "Number of block headers loaded from the blockstore")
+)
 
 type reorg struct {
 	old []*types.TipSet
@@ -107,8 +147,9 @@ type Store struct {
 	// head is the tipset at the head of the best known chain.
 	head *types.TipSet
 
+	// checkPoint is the tipset past which forks are refused.
 	checkPoint types.TipSetKey
-	// Protects head and genesisCid.
+	// Protects head, checkPoint and genesisCid.
 	mu sync.RWMutex
 
 	// headEvents is a pubsub channel that publishes an event every time the head changes.
@@ -131,7 +172,8 @@ type Store struct {
 	reorgCh        chan reorg
 	reorgNotifeeCh chan ReorgNotifee
 
-	tsCache *arc.ARCCache[types.TipSetKey, *types.TipSet]
+	tsCache     *arc.ARCCache[types.TipSetKey, *types.TipSet]
+	headerCache *arc.ARCCache[cid.Cid, *types.BlockHeader]
 
 	tstLk   sync.Mutex
 	tipsets map[abi.ChainEpoch][]cid.Cid
@@ -145,8 +187,17 @@ func NewStore(chainDs repo.Datastore,
 	genesisCid cid.Cid,
 	circulatiingSupplyCalculator ICirculatingSupplyCalcualtor,
 	weight WeightFunc,
+	opts ...StoreOption,
 ) *Store {
-	tsCache, _ := arc.NewARC[types.TipSetKey, *types.TipSet](DefaultTipsetLruCacheSize)
+	options := storeOptions{
+		tipsetCacheSize:      DefaultTipsetLruCacheSize,
+		blockHeaderCacheSize: DefaultBlockHeaderCacheSize,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	tsCache, _ := arc.NewARC[types.TipSetKey, *types.TipSet](options.tipsetCacheSize)
+	headerCache, _ := arc.NewARC[cid.Cid, *types.BlockHeader](options.blockHeaderCacheSize)
 	store := &Store{
 		stateAndBlockSource: cbor.NewCborStore(bsstore),
 		ds:                  chainDs,
@@ -157,6 +208,7 @@ func NewStore(chainDs repo.Datastore,
 		genesis:        genesisCid,
 		reorgNotifeeCh: make(chan ReorgNotifee),
 		tsCache:        tsCache,
+		headerCache:    headerCache,
 		tipsets:        make(map[abi.ChainEpoch][]cid.Cid, constants.Finality),
 		weight:         weight,
 	}
@@ -311,13 +363,21 @@ func (store *Store) Ls(ctx context.Context, fromTS *types.TipSet, count int) ([]
 	return tipsets, nil
 }
 
-// GetBlock returns the block identified by `cid`.
+// GetBlock returns the block identified by `cid`. The header is shared with the block header
+// cache and the other callers, it must not be modified.
 func (store *Store) GetBlock(ctx context.Context, blockID cid.Cid) (*types.BlockHeader, error) {
+	if blk, has := store.headerCache.Get(blockID); has {
+		headerCacheHit.Tick(ctx)
+		return blk, nil
+	}
+	headerCacheMiss.Tick(ctx)
+
 	var block types.BlockHeader
 	err := store.stateAndBlockSource.Get(ctx, blockID, &block)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get block %s", blockID.String())
 	}
+	store.headerCache.Add(blockID, &block)
 	return &block, nil
 }
 
@@ -333,8 +393,10 @@ func (store *Store) GetTipSet(ctx context.Context, key types.TipSetKey) (*types.
 	}
 
 	if val, has := store.tsCache.Get(key); has {
+		tsCacheHit.Tick(ctx)
 		return val, nil
 	}
+	tsCacheMiss.Tick(ctx)
 
 	cids := key.Cids()
 	blks := make([]*types.BlockHeader, len(cids))
@@ -1140,6 +1202,9 @@ func (store *Store) Import(ctx context.Context, r io.Reader) (*types.TipSet, *ty
 
 // SetCheckPoint set current checkpoint
 func (store *Store) SetCheckPoint(checkPoint types.TipSetKey) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
 	store.checkPoint = checkPoint
 }
 
@@ -1287,6 +1352,9 @@ func (store *Store) getCirculatingSupply(ctx context.Context, height abi.ChainEp
 
 // GetCheckPoint get the check point from store or disk.
 func (store *Store) GetCheckPoint() types.TipSetKey {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
 	return store.checkPoint
 }
 
@@ -400,9 +400,8 @@ func TestLoadTipsetMeta(t *testing.T) {
 	}
 
 	_ = os.Setenv("CHAIN_INDEX_CACHE", "2")
-	chain.DefaultTipsetLruCacheSize = 2
-
-	cs := chain.NewStore(ds, bs, genTS.At(0).Cid(), chain.NewMockCirculatingSupplyCalculator(), chainselector.Weight)
+	cs := chain.NewStore(ds, bs, genTS.At(0).Cid(), chain.NewMockCirculatingSupplyCalculator(), chainselector.Weight,
+		chain.WithTipSetCacheSize(2), chain.WithBlockHeaderCacheSize(2))
 	cborStore := &CborBlockStore{Store: cs, cborStore: cst}
 
 	requirePutTestChain(ctx, t, cborStore, head.Key(), builder, 5)
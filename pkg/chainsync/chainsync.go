@@ -3,6 +3,8 @@ package chainsync
 import (
 	"context"
 
+	"github.com/filecoin-project/go-state-types/abi"
+
 	chain2 "github.com/filecoin-project/venus/app/submodule/chain"
 	"github.com/filecoin-project/venus/pkg/chainsync/types"
 	"github.com/filecoin-project/venus/pkg/consensus"
@@ -10,6 +12,7 @@ import (
 	"github.com/filecoin-project/venus/pkg/statemanger"
 	blockstoreutil "github.com/filecoin-project/venus/venus-shared/blockstore"
 	types2 "github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/filecoin-project/venus/pkg/chainsync/dispatcher"
 	"github.com/filecoin-project/venus/pkg/chainsync/syncer"
@@ -22,6 +25,8 @@ type BlockProposer interface {
 	SetConcurrent(number int64)
 	Concurrent() int64
 	SyncTracker() *types.TargetTracker
+	SyncFailures() []types2.SyncFailure
+	PeerHeads() *types.PeerHeads
 	SendHello(ci *types2.ChainInfo) error
 	SendOwnBlock(ci *types2.ChainInfo) error
 	SendGossipBlock(ci *types2.ChainInfo) error
@@ -66,6 +71,15 @@ func (m *Manager) Start(ctx context.Context) error {
 	return nil
 }
 
+// SetCorroboration sets the number of distinct peers that must announce a head before it is
+// synced, heads from trusted peers are synced right away. Heads past the current epoch of
+// chainClock are dropped.
+func (m *Manager) SetCorroboration(minPeers int, trusted []peer.ID, chainClock clock.ChainEpochClock) {
+	m.dispatcher.SetCorroboration(minPeers, trusted, func() abi.ChainEpoch {
+		return chainClock.EpochAtTime(chainClock.Now())
+	})
+}
+
 // BlockProposer returns the block proposer.
 func (m *Manager) BlockProposer() BlockProposer {
 	return m.dispatcher
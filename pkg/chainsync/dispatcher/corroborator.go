@@ -0,0 +1,128 @@
+package dispatcher
+
+import (
+	"sync"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	types2 "github.com/filecoin-project/venus/venus-shared/types"
+)
+
+const (
+	// maxFutureEpochs is how far past the current epoch of the wall clock an announced head can
+	// be, heads further away can't be valid yet and are not kept
+	maxFutureEpochs = 5
+	// maxPendingPerHeight bounds the number of distinct heads waiting for more peers at a height
+	maxPendingPerHeight = 32
+)
+
+// headCorroborator holds back chain heads until enough distinct peers announced them, so a
+// single peer feeding a fake heavier chain can't make the node fetch and validate it. A head
+// counts as announced by a peer when that peer announced any head sharing a block with it.
+// Heads from trusted peers, such as a checkpoint service, are accepted right away.
+type headCorroborator struct {
+	lk sync.Mutex
+
+	minPeers int
+	trusted  map[peer.ID]struct{}
+	// currentEpoch returns the epoch of the wall clock, nil doesn't bound announced heights
+	currentEpoch func() abi.ChainEpoch
+
+	// peers records the distinct peers that announced each block, indexed by height
+	peers map[abi.ChainEpoch]map[cid.Cid]map[peer.ID]struct{}
+	// pending are the heads waiting for more peers, indexed by height
+	pending map[abi.ChainEpoch]map[types2.TipSetKey]*types2.ChainInfo
+}
+
+func newHeadCorroborator(minPeers int, trusted []peer.ID, currentEpoch func() abi.ChainEpoch) *headCorroborator {
+	hc := &headCorroborator{
+		minPeers:     minPeers,
+		trusted:      make(map[peer.ID]struct{}, len(trusted)),
+		currentEpoch: currentEpoch,
+		peers:        make(map[abi.ChainEpoch]map[cid.Cid]map[peer.ID]struct{}),
+		pending:      make(map[abi.ChainEpoch]map[types2.TipSetKey]*types2.ChainInfo),
+	}
+	for _, p := range trusted {
+		hc.trusted[p] = struct{}{}
+	}
+	return hc
+}
+
+// observe records that ci.Sender announced ci and returns the heads that are now corroborated,
+// including ci itself once it is. Heads at or below localHeight are forgotten. Heads from the
+// future and heads beyond maxPendingPerHeight at their height are dropped.
+func (hc *headCorroborator) observe(ci *types2.ChainInfo, localHeight abi.ChainEpoch) []*types2.ChainInfo {
+	if hc.minPeers <= 1 {
+		return []*types2.ChainInfo{ci}
+	}
+
+	hc.lk.Lock()
+	defer hc.lk.Unlock()
+
+	hc.prune(localHeight)
+
+	ts := ci.FullTipSet.TipSet()
+	if _, ok := hc.trusted[ci.Sender]; ok {
+		if pending, ok := hc.pending[ts.Height()]; ok {
+			delete(pending, ts.Key())
+		}
+		return []*types2.ChainInfo{ci}
+	}
+	if ts.Height() <= localHeight {
+		// not heavier than what we have, the target tracker drops it anyway
+		return []*types2.ChainInfo{ci}
+	}
+	if hc.currentEpoch != nil && ts.Height() > hc.currentEpoch()+maxFutureEpochs {
+		log.Debugw("dropping head announced from the future", "height", ts.Height(), "from", ci.Sender)
+		return nil
+	}
+	if _, ok := hc.pending[ts.Height()][ts.Key()]; !ok && len(hc.pending[ts.Height()]) >= maxPendingPerHeight {
+		log.Debugw("too many heads waiting for peers, dropping head", "height", ts.Height(), "from", ci.Sender)
+		return nil
+	}
+
+	if hc.peers[ts.Height()] == nil {
+		hc.peers[ts.Height()] = make(map[cid.Cid]map[peer.ID]struct{})
+	}
+	blocks := hc.peers[ts.Height()]
+	for _, c := range ts.Cids() {
+		if blocks[c] == nil {
+			blocks[c] = make(map[peer.ID]struct{})
+		}
+		blocks[c][ci.Sender] = struct{}{}
+	}
+	if hc.pending[ts.Height()] == nil {
+		hc.pending[ts.Height()] = make(map[types2.TipSetKey]*types2.ChainInfo)
+	}
+	hc.pending[ts.Height()][ts.Key()] = ci
+
+	var ready []*types2.ChainInfo
+	for key, pci := range hc.pending[ts.Height()] {
+		if hc.corroborated(pci.FullTipSet.TipSet()) {
+			ready = append(ready, pci)
+			delete(hc.pending[ts.Height()], key)
+		}
+	}
+	return ready
+}
+
+func (hc *headCorroborator) corroborated(ts *types2.TipSet) bool {
+	peers := make(map[peer.ID]struct{})
+	for _, c := range ts.Cids() {
+		for p := range hc.peers[ts.Height()][c] {
+			peers[p] = struct{}{}
+		}
+	}
+	return len(peers) >= hc.minPeers
+}
+
+func (hc *headCorroborator) prune(localHeight abi.ChainEpoch) {
+	for h := range hc.peers {
+		if h <= localHeight {
+			delete(hc.peers, h)
+			delete(hc.pending, h)
+		}
+	}
+}
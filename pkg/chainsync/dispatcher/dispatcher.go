@@ -9,10 +9,12 @@ import (
 	atmoic2 "sync/atomic"
 	"time"
 
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/pubsub"
 	"github.com/filecoin-project/venus/pkg/chain"
 	"github.com/filecoin-project/venus/pkg/chainsync/types"
 	types2 "github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/streadway/handy/atomic"
 
 	logging "github.com/ipfs/go-log/v2"
@@ -26,6 +28,9 @@ const DefaultInQueueSize = 5
 // DefaultWorkQueueSize is the bucketSize of the work queue
 const DefaultWorkQueueSize = 15
 
+// DefaultFailureLogSize is the number of sync failures kept for diagnosis
+const DefaultFailureLogSize = 256
+
 const LocalIncoming = "incoming"
 
 // dispatchSyncer is the interface of the logic syncing incoming chains
@@ -42,8 +47,11 @@ func NewDispatcher(catchupSyncer dispatchSyncer, chainStore *chain.Store) *Dispa
 
 // NewDispatcherWithSizes creates a new syncing dispatcher.
 func NewDispatcherWithSizes(syncer dispatchSyncer, chainStore *chain.Store, workQueueSize, inQueueSize int) *Dispatcher {
+	peerHeads := types.NewPeerHeads()
+	workTracker := types.NewTargetTracker(workQueueSize)
+	workTracker.SetSupport(peerHeads.Support)
 	return &Dispatcher{
-		workTracker:     types.NewTargetTracker(workQueueSize),
+		workTracker:     workTracker,
 		syncer:          syncer,
 		incoming:        make(chan *types.Target, inQueueSize),
 		control:         make(chan interface{}, 1),
@@ -52,6 +60,9 @@ func NewDispatcherWithSizes(syncer dispatchSyncer, chainStore *chain.Store, work
 		maxCount:        1,
 		incomingPubsub:  pubsub.New(50),
 		chainStore:      chainStore,
+		corroborator:    newHeadCorroborator(1, nil, nil),
+		failures:        types.NewFailureLog(DefaultFailureLogSize),
+		peerHeads:       peerHeads,
 	}
 }
 
@@ -95,6 +106,34 @@ type Dispatcher struct {
 
 	incomingPubsub *pubsub.PubSub
 	chainStore     *chain.Store
+
+	// corroborator holds back heads from peers until enough distinct peers announced them
+	corroborator *headCorroborator
+	// failures are the most recent targets that failed to sync
+	failures *types.FailureLog
+	// peerHeads are the heads last announced by the connected peers
+	peerHeads *types.PeerHeads
+}
+
+// SyncFailures returns the most recent targets that failed to sync, oldest first.
+func (d *Dispatcher) SyncFailures() []types2.SyncFailure {
+	return d.failures.List()
+}
+
+// PeerHeads returns the heads last announced by the connected peers.
+func (d *Dispatcher) PeerHeads() *types.PeerHeads {
+	return d.peerHeads
+}
+
+// SetCorroboration requires heads from hello to be announced by at least minPeers distinct
+// peers before they are synced. Heads sent by trusted peers are synced right away. Gossip
+// blocks are attributed to the single peer that relayed them and already passed the pubsub
+// validation, so they are not held back. currentEpoch bounds the heights of the heads kept
+// while waiting.
+func (d *Dispatcher) SetCorroboration(minPeers int, trusted []peer.ID, currentEpoch func() abi.ChainEpoch) {
+	d.lk.Lock()
+	defer d.lk.Unlock()
+	d.corroborator = newHeadCorroborator(minPeers, trusted, currentEpoch)
 }
 
 // SyncTracker returnss the target tracker of syncing
@@ -102,7 +141,7 @@ func (d *Dispatcher) SyncTracker() *types.TargetTracker {
 	return d.workTracker
 }
 
-func (d *Dispatcher) sendHead(ci *types2.ChainInfo) error {
+func (d *Dispatcher) sendHead(ci *types2.ChainInfo, corroborate bool) error {
 	ctx := context.Background()
 	fts := ci.FullTipSet
 	if fts == nil {
@@ -125,22 +164,39 @@ func (d *Dispatcher) sendHead(ci *types2.ChainInfo) error {
 
 	d.incomingPubsub.Pub(fts.TipSet().Blocks(), LocalIncoming)
 
-	return d.addTracker(ci)
+	if !corroborate {
+		return d.addTracker(ci)
+	}
+	d.peerHeads.Update(ci.Sender, fts.TipSet())
+
+	d.lk.Lock()
+	corroborator := d.corroborator
+	d.lk.Unlock()
+	ready := corroborator.observe(ci, d.syncer.Head().Height())
+	if len(ready) == 0 {
+		log.Debugw("waiting for more peers to announce tipset", "height", fts.TipSet().Height(), "from", ci.Sender)
+	}
+	for _, rci := range ready {
+		if err := d.addTracker(rci); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // SendHello handles chain information from bootstrap peers.
 func (d *Dispatcher) SendHello(ci *types2.ChainInfo) error {
-	return d.sendHead(ci)
+	return d.sendHead(ci, true)
 }
 
 // SendOwnBlock handles chain info from a node's own mining system
 func (d *Dispatcher) SendOwnBlock(ci *types2.ChainInfo) error {
-	return d.sendHead(ci)
+	return d.sendHead(ci, false)
 }
 
 // SendGossipBlock handles chain info from new blocks sent on pubsub
 func (d *Dispatcher) SendGossipBlock(ci *types2.ChainInfo) error {
-	return d.sendHead(ci)
+	return d.sendHead(ci, false)
 }
 
 func (d *Dispatcher) addTracker(ci *types2.ChainInfo) error {
@@ -290,6 +346,7 @@ func (d *Dispatcher) syncWorker(ctx context.Context) {
 						err := d.syncer.HandleNewTipSet(ctx, syncTarget)
 						if err != nil {
 							log.Infof("failed sync of %v at %d  %s", syncTarget.Head.Key(), syncTarget.Head.Height(), err)
+							d.failures.Add(syncTarget, err)
 						}
 						d.workTracker.Remove(syncTarget)
 						d.registeredCb(syncTarget, err)
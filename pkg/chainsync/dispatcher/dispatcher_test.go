@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/venus/pkg/chain"
@@ -201,3 +202,120 @@ func chainInfoWithHeightAndWeight(t *testing.T, h int, weight int64) *types.Chai
 		},
 	}
 }
+
+func TestDispatchCorroboration(t *testing.T) {
+	tf.UnitTest(t)
+	s := &mockSyncer{
+		headsCalled: make([]*types.TipSet, 0),
+	}
+	builder := chain.NewBuilder(t, address.Undef)
+
+	testDispatch := dispatcher.NewDispatcher(s, builder.Store())
+	trusted := peer.ID("trusted")
+	testDispatch.SetCorroboration(2, []peer.ID{trusted}, func() abi.ChainEpoch { return 100 })
+
+	synced := make(chan types.TipSetKey, 10)
+	testDispatch.RegisterCallback(func(target *syncTypes.Target, _ error) {
+		synced <- target.Head.Key()
+	})
+	testDispatch.Start(context.Background())
+
+	waitSynced := func(expect *types.ChainInfo) {
+		select {
+		case key := <-synced:
+			assert.Equal(t, expect.FullTipSet.TipSet().Key(), key)
+		case <-time.After(time.Second * 5):
+			assert.Failf(t, "", "tipset at %d was not synced", expect.FullTipSet.TipSet().Height())
+		}
+	}
+	assertNotSynced := func(msg string) {
+		select {
+		case <-synced:
+			assert.Fail(t, msg)
+		case <-time.After(time.Millisecond * 200):
+		}
+	}
+
+	head := chainInfoWithHeightAndWeight(t, 10, 100)
+	head.Sender = peer.ID("eclipse")
+	require.NoError(t, testDispatch.SendHello(head))
+	// the same peer announcing again doesn't count twice
+	require.NoError(t, testDispatch.SendHello(head))
+	assertNotSynced("tipset announced by a single peer was synced")
+
+	corroborated := *head
+	corroborated.Sender = peer.ID("honest")
+	require.NoError(t, testDispatch.SendHello(&corroborated))
+	waitSynced(head)
+
+	checkpoint := chainInfoWithHeightAndWeight(t, 11, 101)
+	checkpoint.Sender = trusted
+	require.NoError(t, testDispatch.SendHello(checkpoint))
+	waitSynced(checkpoint)
+
+	// gossip blocks are relayed by a single peer and are not held back
+	gossip := chainInfoWithHeightAndWeight(t, 12, 102)
+	gossip.Sender = peer.ID("relay")
+	require.NoError(t, testDispatch.SendGossipBlock(gossip))
+	waitSynced(gossip)
+
+	// heads from the future are dropped, however many peers announce them
+	future := chainInfoWithHeightAndWeight(t, 1000, 1000)
+	for _, p := range []peer.ID{"a", "b", "c"} {
+		fci := *future
+		fci.Sender = p
+		require.NoError(t, testDispatch.SendHello(&fci))
+	}
+	assertNotSynced("tipset from the future was synced")
+}
+
+func TestDispatchCorroborationPendingLimit(t *testing.T) {
+	tf.UnitTest(t)
+	s := &mockSyncer{
+		headsCalled: make([]*types.TipSet, 0),
+	}
+	builder := chain.NewBuilder(t, address.Undef)
+
+	testDispatch := dispatcher.NewDispatcher(s, builder.Store())
+	testDispatch.SetCorroboration(2, nil, nil)
+
+	synced := make(chan types.TipSetKey, 10)
+	testDispatch.RegisterCallback(func(target *syncTypes.Target, _ error) {
+		synced <- target.Head.Key()
+	})
+	testDispatch.Start(context.Background())
+
+	// fill the heads waiting at height 10, every head has a different parent weight
+	var first *types.ChainInfo
+	for i := 0; i < 32; i++ {
+		ci := chainInfoWithHeightAndWeight(t, 10, int64(100+i))
+		ci.Sender = peer.ID("spammer")
+		require.NoError(t, testDispatch.SendHello(ci))
+		if first == nil {
+			first = ci
+		}
+	}
+
+	extra := chainInfoWithHeightAndWeight(t, 10, 200)
+	for _, p := range []peer.ID{"a", "b"} {
+		eci := *extra
+		eci.Sender = p
+		require.NoError(t, testDispatch.SendHello(&eci))
+	}
+	select {
+	case <-synced:
+		assert.Fail(t, "head beyond the pending limit was synced")
+	case <-time.After(time.Millisecond * 200):
+	}
+
+	// heads already waiting can still be corroborated
+	confirm := *first
+	confirm.Sender = peer.ID("honest")
+	require.NoError(t, testDispatch.SendHello(&confirm))
+	select {
+	case key := <-synced:
+		assert.Equal(t, first.FullTipSet.TipSet().Key(), key)
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "waiting head was not synced")
+	}
+}
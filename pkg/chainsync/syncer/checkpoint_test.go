@@ -0,0 +1,43 @@
+package syncer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/chain"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestCheckCheckPointWalk(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	builder := chain.NewBuilder(t, address.Undef)
+	store := builder.Store()
+	checkPoint := builder.AppendManyOn(ctx, 3, builder.Genesis())
+	head := builder.AppendManyOn(ctx, 3, checkPoint)
+	next := builder.AppendOn(ctx, head, 1)
+
+	walks := 0
+	s := &Syncer{
+		chainStore: store,
+		ancestorAt: func(ctx context.Context, ts *types.TipSet, height abi.ChainEpoch, prev bool) (*types.TipSet, error) {
+			walks++
+			return store.GetTipSetByHeight(ctx, ts, height, prev)
+		},
+	}
+
+	// without a checkpoint set, the default genesis one doesn't walk back the chain
+	require.Equal(t, builder.Genesis().Key(), store.GetCheckPoint())
+	require.NoError(t, s.checkCheckPoint(ctx, []*types.TipSet{next}))
+	require.Equal(t, 0, walks)
+
+	store.SetCheckPoint(checkPoint.Key())
+	require.NoError(t, s.checkCheckPoint(ctx, []*types.TipSet{next}))
+	require.Equal(t, 1, walks)
+}
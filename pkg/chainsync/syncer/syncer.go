@@ -20,6 +20,7 @@ import (
 	syncTypes "github.com/filecoin-project/venus/pkg/chainsync/types"
 	cbor "github.com/ipfs/go-ipld-cbor"
 
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/venus/pkg/chain"
 	"github.com/filecoin-project/venus/pkg/clock"
@@ -58,6 +59,8 @@ var (
 	ErrChainHasBadTipSet = errors.New("input chain contains a cached bad tipset")
 	// ErrNewChainTooLong is returned when processing a fork that split off from the main chain too many blocks ago.
 	ErrNewChainTooLong = errors.New("input chain forked from best chain past finality limit")
+	// ErrForkPastCheckPoint is returned when the syncing chain doesn't pass through the checkpoint of the chain store.
+	ErrForkPastCheckPoint = errors.New("input chain forked from best chain past checkpoint")
 	// ErrUnexpectedStoreState indicates that the syncer's chain bsstore is violating expected invariants.
 	ErrUnexpectedStoreState = errors.New("the chain bsstore is in an unexpected state")
 
@@ -137,8 +140,9 @@ type Syncer struct {
 
 	clock clock.Clock
 
-	bsstore    blockstoreutil.Blockstore
-	checkPoint types.TipSetKey
+	bsstore blockstoreutil.Blockstore
+	// ancestorAt finds the tipset at or before height in the chain of ts.
+	ancestorAt func(ctx context.Context, ts *types.TipSet, height abi.ChainEpoch, prev bool) (*types.TipSet, error)
 
 	fork fork.IFork
 
@@ -172,6 +176,7 @@ func NewSyncer(stmgr *statemanger.Stmgr,
 		clock:           c,
 		fork:            fork,
 		stmgr:           stmgr,
+		ancestorAt:      s.GetTipSetByHeight,
 	}
 
 	defer func() {
@@ -199,45 +204,41 @@ func (syncer *Syncer) syncOne(ctx context.Context, parent, next *types.TipSet) e
 	stopwatch := syncOneTimer.Start()
 	defer stopwatch(ctx)
 
-	var err error
-
-	if !parent.Key().Equals(syncer.checkPoint) {
-		var wg errgroup.Group
-		for i := 0; i < next.Len(); i++ {
-			blk := next.At(i)
-			wg.Go(func() error {
-				// Fetch the URL.
-				err := syncer.blockValidator.ValidateFullBlock(ctx, blk)
-				if err == nil {
-					if err := syncer.chainStore.AddToTipSetTracker(ctx, blk); err != nil {
-						return fmt.Errorf("failed to add validated header to tipset tracker: %w", err)
-					}
+	var wg errgroup.Group
+	for i := 0; i < next.Len(); i++ {
+		blk := next.At(i)
+		wg.Go(func() error {
+			// Fetch the URL.
+			err := syncer.blockValidator.ValidateFullBlock(ctx, blk)
+			if err == nil {
+				if err := syncer.chainStore.AddToTipSetTracker(ctx, blk); err != nil {
+					return fmt.Errorf("failed to add validated header to tipset tracker: %w", err)
 				}
-				return err
-			})
-		}
-		err = wg.Wait()
-		if err != nil {
-			var rootNotMatch bool // nolint
-
-			if merr, isok := err.(*multierror.Error); isok {
-				for _, e := range merr.Errors {
-					if isRootNotMatch(e) {
-						rootNotMatch = true
-						break
-					}
-				}
-			} else {
-				rootNotMatch = isRootNotMatch(err) // nolint
 			}
+			return err
+		})
+	}
+	err := wg.Wait()
+	if err != nil {
+		var rootNotMatch bool // nolint
 
-			if rootNotMatch { // nolint
-				// todo: should here rollback, and re-compute?
-				_ = syncer.stmgr.Rollback(ctx, parent, next)
+		if merr, isok := err.(*multierror.Error); isok {
+			for _, e := range merr.Errors {
+				if isRootNotMatch(e) {
+					rootNotMatch = true
+					break
+				}
 			}
+		} else {
+			rootNotMatch = isRootNotMatch(err) // nolint
+		}
 
-			return fmt.Errorf("validate mining failed %w", err)
+		if rootNotMatch { // nolint
+			// todo: should here rollback, and re-compute?
+			_ = syncer.stmgr.Rollback(ctx, parent, next)
 		}
+
+		return fmt.Errorf("validate mining failed %w", err)
 	}
 
 	syncer.chainStore.PersistTipSetKey(ctx, next.Key())
@@ -300,10 +301,14 @@ func (syncer *Syncer) HandleNewTipSet(ctx context.Context, target *syncTypes.Tar
 	syncer.exchangeClient.AddPeer(target.Sender)
 	tipsets, err := syncer.fetchChainBlocks(ctx, head, target.Head)
 	if err != nil {
-		return errors.Wrapf(err, "failure fetching or validating headers")
+		return &syncTypes.StageError{Stage: syncTypes.StageHeaders, Err: errors.Wrapf(err, "failure fetching or validating headers")}
 	}
 	logSyncer.Debugf("fetch header success at %v %s ...", tipsets[0].Height(), tipsets[0].Key())
 
+	if err = syncer.checkCheckPoint(ctx, tipsets); err != nil {
+		return &syncTypes.StageError{Stage: syncTypes.StageHeaders, Err: err}
+	}
+
 	if err = syncer.syncSegement(ctx, target, tipsets); err == nil {
 		syncer.delayRunTx.update(tipsets[len(tipsets)-1])
 	}
@@ -311,6 +316,45 @@ func (syncer *Syncer) HandleNewTipSet(ctx context.Context, target *syncTypes.Tar
 	return err
 }
 
+// checkCheckPoint refuses the tipsets fetched for a target when their chain doesn't pass through
+// the checkpoint of the chain store, so a fork starting below the checkpoint is never synced.
+func (syncer *Syncer) checkCheckPoint(ctx context.Context, tipsets []*types.TipSet) error {
+	cpTS, err := syncer.chainStore.GetTipSet(ctx, syncer.chainStore.GetCheckPoint())
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	// the checkpoint defaults to the genesis, which every chain passes through, only one set by
+	// AdminCheckpoint is worth walking back the chain for
+	if cpTS.Height() == 0 {
+		return nil
+	}
+	base, err := syncer.chainStore.GetTipSet(ctx, tipsets[0].Parents())
+	if err != nil {
+		return err
+	}
+
+	if base.Height() >= cpTS.Height() {
+		ancestor, err := syncer.ancestorAt(ctx, base, cpTS.Height(), true)
+		if err != nil {
+			return err
+		}
+		if !ancestor.Equals(cpTS) {
+			return ErrForkPastCheckPoint
+		}
+		return nil
+	}
+
+	for _, ts := range tipsets {
+		if ts.Height() >= cpTS.Height() {
+			if !ts.Equals(cpTS) {
+				return ErrForkPastCheckPoint
+			}
+			return nil
+		}
+	}
+	return ErrForkPastCheckPoint
+}
+
 func (syncer *Syncer) syncSegement(ctx context.Context, target *syncTypes.Target, tipsets []*types.TipSet) error {
 	parent, err := syncer.chainStore.GetTipSet(ctx, tipsets[0].Parents())
 	if err != nil {
@@ -328,12 +372,12 @@ func (syncer *Syncer) syncSegement(ctx context.Context, target *syncTypes.Target
 		logSyncer.Debugf("start to fetch message segement %d-%d", startTip, emdTipset)
 		_, err := syncer.fetchSegMessage(ctx, segTipset)
 		if err != nil {
-			return err
+			return &syncTypes.StageError{Stage: syncTypes.StageMessages, Err: err}
 		}
 		logSyncer.Debugf("finish to fetch message segement %d-%d", startTip, emdTipset)
 		err = <-errProcessChan
 		if err != nil {
-			return fmt.Errorf("process message failed %v", err)
+			return &syncTypes.StageError{Stage: syncTypes.StageExecution, Err: fmt.Errorf("process message failed %v", err)}
 		}
 		wg.Add(1)
 		go func() {
@@ -346,12 +390,10 @@ func (syncer *Syncer) syncSegement(ctx context.Context, target *syncTypes.Target
 				errProcessChan <- processErr
 				return
 			}
-			if !parent.Key().Equals(syncer.checkPoint) {
-				logSyncer.Debugf("set chain head, height:%d, blocks:%d", parent.Height(), parent.Len())
-				if err := syncer.chainStore.RefreshHeaviestTipSet(ctx, parent.Height()); err != nil {
-					errProcessChan <- err
-					return
-				}
+			logSyncer.Debugf("set chain head, height:%d, blocks:%d", parent.Height(), parent.Len())
+			if err := syncer.chainStore.RefreshHeaviestTipSet(ctx, parent.Height()); err != nil {
+				errProcessChan <- err
+				return
 			}
 			errProcessChan <- nil
 		}()
@@ -363,7 +405,10 @@ func (syncer *Syncer) syncSegement(ctx context.Context, target *syncTypes.Target
 	wg.Wait()
 	select {
 	case err = <-errProcessChan:
-		return err
+		if err != nil {
+			return &syncTypes.StageError{Stage: syncTypes.StageExecution, Err: err}
+		}
+		return nil
 	default:
 		return nil
 	}
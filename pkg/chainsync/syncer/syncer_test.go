@@ -264,6 +264,35 @@ func TestAcceptHeavierFork(t *testing.T) {
 	verifyHead(t, builder.Store(), fork3)
 }
 
+func TestRejectForkPastCheckPoint(t *testing.T) {
+	tf.UnitTest(t)
+	ctx := context.Background()
+	builder, s := setup(ctx, t)
+	genesis := builder.Store().GetHead()
+
+	forkbase := builder.AppendOn(ctx, genesis, 1)
+	checkPoint := builder.AppendOn(ctx, forkbase, 1)
+	head := builder.AppendManyOn(ctx, 2, checkPoint)
+	require.NoError(t, s.HandleNewTipSet(ctx, &syncTypes.Target{Head: head}))
+	require.NoError(t, builder.FlushHead(ctx))
+	verifyHead(t, builder.Store(), head)
+	builder.Store().SetCheckPoint(checkPoint.Key())
+
+	// Avoid miners having two blocks at the same height
+	builder.ResetMiners()
+	// a heavier fork from below the checkpoint is refused
+	fork := builder.AppendManyOn(ctx, 3, builder.AppendOn(ctx, forkbase, 3))
+	assert.ErrorIs(t, s.HandleNewTipSet(ctx, &syncTypes.Target{Head: fork}), syncer.ErrForkPastCheckPoint)
+	verifyHead(t, builder.Store(), head)
+
+	// while one from the checkpoint is taken
+	builder.ResetMiners()
+	fork = builder.AppendManyOn(ctx, 3, builder.AppendOn(ctx, checkPoint, 3))
+	require.NoError(t, s.HandleNewTipSet(ctx, &syncTypes.Target{Head: fork}))
+	require.NoError(t, builder.FlushHead(ctx))
+	verifyHead(t, builder.Store(), fork)
+}
+
 func TestRejectFinalityFork(t *testing.T) {
 	tf.UnitTest(t)
 	ctx := context.Background()
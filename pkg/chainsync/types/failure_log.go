@@ -0,0 +1,81 @@
+package types
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// The steps of syncing a target, used to tell where a sync failed.
+const (
+	StageTarget    = "target"
+	StageHeaders   = "headers"
+	StageMessages  = "messages"
+	StageExecution = "execution"
+)
+
+// StageError is a sync error annotated with the step that failed.
+type StageError struct {
+	Stage string
+	Err   error
+}
+
+func (e *StageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// FailureLog keeps the most recent sync failures, so "stuck at height X" reports can be
+// diagnosed from the peers and errors involved.
+type FailureLog struct {
+	lk       sync.Mutex
+	failures []types.SyncFailure
+	next     int
+	full     bool
+}
+
+func NewFailureLog(size int) *FailureLog {
+	return &FailureLog{failures: make([]types.SyncFailure, size)}
+}
+
+// Add records that syncing target failed with err.
+func (fl *FailureLog) Add(target *Target, err error) {
+	failure := types.SyncFailure{
+		Time:   time.Now(),
+		Sender: target.Sender,
+		Head:   target.Head.Key(),
+		Height: target.Head.Height(),
+		Stage:  StageTarget,
+		Error:  err.Error(),
+	}
+	var stageErr *StageError
+	if errors.As(err, &stageErr) {
+		failure.Stage = stageErr.Stage
+	}
+	if target.Current != nil {
+		failure.Reached = target.Current.Height()
+	}
+
+	fl.lk.Lock()
+	defer fl.lk.Unlock()
+	fl.failures[fl.next] = failure
+	fl.next = (fl.next + 1) % len(fl.failures)
+	if fl.next == 0 {
+		fl.full = true
+	}
+}
+
+// List returns the recorded failures, oldest first.
+func (fl *FailureLog) List() []types.SyncFailure {
+	fl.lk.Lock()
+	defer fl.lk.Unlock()
+	if !fl.full {
+		return append([]types.SyncFailure(nil), fl.failures[:fl.next]...)
+	}
+	return append(append([]types.SyncFailure(nil), fl.failures[fl.next:]...), fl.failures[:fl.next]...)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/testutil"
+	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailureLog(t *testing.T) {
+	tf.UnitTest(t)
+	fl := NewFailureLog(2)
+
+	var ts types.TipSet
+	testutil.Provide(t, &ts)
+	target := &Target{Head: &ts, Sender: "peer"}
+
+	fl.Add(target, errors.New("no stage"))
+	failures := fl.List()
+	assert.Len(t, failures, 1)
+	assert.Equal(t, StageTarget, failures[0].Stage)
+	assert.Equal(t, ts.Key(), failures[0].Head)
+	assert.Equal(t, target.Sender, failures[0].Sender)
+
+	target.Current = &ts
+	fl.Add(target, fmt.Errorf("wrapped: %w", &StageError{Stage: StageMessages, Err: errors.New("missing messages")}))
+	fl.Add(target, &StageError{Stage: StageExecution, Err: errors.New("bad state root")})
+
+	// the oldest failure is dropped once the log is full
+	failures = fl.List()
+	assert.Len(t, failures, 2)
+	assert.Equal(t, StageMessages, failures[0].Stage)
+	assert.Equal(t, StageExecution, failures[1].Stage)
+	assert.Equal(t, "bad state root", failures[1].Error)
+	assert.Equal(t, ts.Height(), failures[1].Reached)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+type peerHead struct {
+	head    *types.TipSet
+	updated time.Time
+}
+
+// PeerHeads tracks the head announced by each connected peer, through hello or gossip, for as
+// long as the peer stays connected.
+type PeerHeads struct {
+	lk    sync.Mutex
+	heads map[peer.ID]peerHead
+}
+
+func NewPeerHeads() *PeerHeads {
+	return &PeerHeads{heads: make(map[peer.ID]peerHead)}
+}
+
+// Update records head as announced by p. A head below the one already recorded for p is
+// ignored, it's a late block relayed by p rather than its head.
+func (ph *PeerHeads) Update(p peer.ID, head *types.TipSet) {
+	ph.lk.Lock()
+	defer ph.lk.Unlock()
+
+	if cur, ok := ph.heads[p]; ok && cur.head.Height() > head.Height() {
+		return
+	}
+	ph.heads[p] = peerHead{head: head, updated: time.Now()}
+}
+
+// Remove forgets the head of p, once it disconnects.
+func (ph *PeerHeads) Remove(p peer.ID) {
+	ph.lk.Lock()
+	defer ph.lk.Unlock()
+	delete(ph.heads, p)
+}
+
+// Support returns the number of peers whose head shares a block with ts.
+func (ph *PeerHeads) Support(ts *types.TipSet) int {
+	ph.lk.Lock()
+	defer ph.lk.Unlock()
+
+	count := 0
+	for _, h := range ph.heads {
+		if h.head.Height() != ts.Height() {
+			continue
+		}
+		for _, c := range h.head.Cids() {
+			if ts.Key().Has(c) {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// List returns the head of every peer compared with local, heaviest first. Weights are parent
+// weights, which are known without executing the heads.
+func (ph *PeerHeads) List(local *types.TipSet) []types.PeerHead {
+	ph.lk.Lock()
+	defer ph.lk.Unlock()
+
+	localWeight := local.ParentWeight()
+	out := make([]types.PeerHead, 0, len(ph.heads))
+	for p, h := range ph.heads {
+		weight := h.head.ParentWeight()
+		out = append(out, types.PeerHead{
+			Peer:        p,
+			Head:        h.head.Key(),
+			Height:      h.head.Height(),
+			Weight:      weight,
+			HeightDelta: h.head.Height() - local.Height(),
+			WeightDelta: big.Sub(weight, localWeight),
+			Updated:     h.updated,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].Weight.Equals(out[j].Weight) {
+			return out[i].Weight.GreaterThan(out[j].Weight)
+		}
+		return out[i].Peer < out[j].Peer
+	})
+	return out
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/testutil"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func mkHead(t *testing.T, height abi.ChainEpoch, weight int64) *types.TipSet {
+	var blk types.BlockHeader
+	testutil.Provide(t, &blk)
+	blk.Height = height
+	blk.ParentWeight = big.NewInt(weight)
+	ts, err := types.NewTipSet([]*types.BlockHeader{&blk})
+	require.NoError(t, err)
+	return ts
+}
+
+func TestPeerHeads(t *testing.T) {
+	tf.UnitTest(t)
+	ph := NewPeerHeads()
+
+	local := mkHead(t, 10, 100)
+	heavy := mkHead(t, 12, 120)
+	ph.Update("a", mkHead(t, 9, 90))
+	ph.Update("b", heavy)
+	ph.Update("c", heavy)
+
+	heads := ph.List(local)
+	require.Len(t, heads, 3)
+	assert.Equal(t, heavy.Key(), heads[0].Head)
+	assert.Equal(t, abi.ChainEpoch(2), heads[0].HeightDelta)
+	assert.Equal(t, big.NewInt(20), heads[0].WeightDelta)
+	assert.Equal(t, abi.ChainEpoch(-1), heads[2].HeightDelta)
+	assert.Equal(t, 2, ph.Support(heavy))
+
+	// a late block relayed by a peer doesn't replace its head
+	ph.Update("b", mkHead(t, 11, 110))
+	assert.Equal(t, 2, ph.Support(heavy))
+
+	ph.Remove("c")
+	assert.Equal(t, 1, ph.Support(heavy))
+	assert.Len(t, ph.List(local), 2)
+}
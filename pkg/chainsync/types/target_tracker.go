@@ -89,6 +89,9 @@ type TargetTracker struct {
 	subLk sync.Mutex
 
 	tipsetCache map[abi.ChainEpoch][]*types.BlockHeader
+
+	// support returns the number of peers announcing a head, it ranks targets of the same weight
+	support func(*types.TipSet) int
 }
 
 // NewTargetTracker returns a new target queue.
@@ -103,9 +106,18 @@ func NewTargetTracker(size int) *TargetTracker {
 		lowWeight:   fbig.NewInt(0),
 		subs:        make(map[string]chan struct{}),
 		tipsetCache: make(map[abi.ChainEpoch][]*types.BlockHeader),
+		support:     func(*types.TipSet) int { return 0 },
 	}
 }
 
+// SetSupport sets the function counting the peers announcing a head, targets of the same weight
+// announced by more peers are synced first.
+func (tq *TargetTracker) SetSupport(support func(*types.TipSet) int) {
+	tq.lk.Lock()
+	defer tq.lk.Unlock()
+	tq.support = support
+}
+
 func (tq *TargetTracker) SubNewTarget(key string, cacheSize int) chan struct{} {
 	tq.subLk.Lock()
 	defer tq.subLk.Unlock()
@@ -248,7 +260,7 @@ func (tq *TargetTracker) Add(t *Target) bool {
 	}
 
 	tq.targetSet[t.Head.String()] = t
-	sortTarget(tq.q)
+	sortTarget(tq.q, tq.support)
 	// update lowweight
 	tq.lowWeight = tq.q[len(tq.q)-1].Head.At(0).ParentWeight
 
@@ -259,8 +271,9 @@ func (tq *TargetTracker) Add(t *Target) bool {
 	return true
 }
 
-// sort by weight and than sort by block number in target buckets
-func sortTarget(target TargetBuckets) {
+// sort by weight, than by the number of peers announcing the head and than by block number in
+// target buckets
+func sortTarget(target TargetBuckets, support func(*types.TipSet) int) {
 	// use weight as group key
 	groups := make(map[string][]*Target)
 	var keys []fbig.Int
@@ -279,10 +292,20 @@ func sortTarget(target TargetBuckets) {
 		return keys[i].GreaterThan(keys[j])
 	})
 
-	// sort target in group by block number
+	// sort target in group by peers and block number
 	for _, key := range keys {
 		inGroup := groups[key.String()]
-		sort.Slice(inGroup, func(i, j int) bool {
+		if len(inGroup) < 2 {
+			continue
+		}
+		peers := make(map[*Target]int, len(inGroup))
+		for _, t := range inGroup {
+			peers[t] = support(t.Head)
+		}
+		sort.SliceStable(inGroup, func(i, j int) bool {
+			if peers[inGroup[i]] != peers[inGroup[j]] {
+				return peers[inGroup[i]] > peers[inGroup[j]]
+			}
 			return inGroup[i].Head.Len() > inGroup[j].Head.Len()
 		})
 	}
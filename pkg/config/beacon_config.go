@@ -11,9 +11,22 @@ const (
 	DrandQuicknet
 )
 
+// DrandNetworks maps the names of the known drand networks to their config
+var DrandNetworks = map[string]DrandEnum{
+	"mainnet":    DrandMainnet,
+	"quicknet":   DrandQuicknet,
+	"testnet":    DrandTestnet,
+	"devnet":     DrandDevnet,
+	"incentinet": DrandIncentinet,
+}
+
 type DrandConf struct {
-	Servers       []string
-	Relays        []string
+	Servers []string
+	Relays  []string
+	// ChainHash pins the drand chain, chain info fetched from the servers or set in ChainInfoJSON
+	// must hash to it
+	ChainHash string
+	// ChainInfoJSON is the chain info of the drand network, it is fetched from the servers when empty
 	ChainInfoJSON string
 	IsChained     bool
 }
@@ -34,6 +47,7 @@ var DrandConfigs = map[DrandEnum]DrandConf{
 			"/dnsaddr/api3.drand.sh/",
 		},
 		IsChained:     true,
+		ChainHash:     "8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce",
 		ChainInfoJSON: `{"public_key":"868f005eb8e6e4ca0a47c8a77ceaa5309a47978a7c71bc5cce96366b5d7a569937c529eeda66c7293784a9402801af31","period":30,"genesis_time":1595431050,"hash":"8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce","groupHash":"176f93498eac9ca337150b46d21dd58673ea4e3581185f869672e59fa4cb390a"}`,
 	},
 	DrandQuicknet: {
@@ -50,6 +64,7 @@ var DrandConfigs = map[DrandEnum]DrandConf{
 			"/dnsaddr/api3.drand.sh/",
 		},
 		IsChained:     false,
+		ChainHash:     "52db9ba70e0cc0f6eaf7803dd07447a1f5477735fd3f661792ba94600c84e971",
 		ChainInfoJSON: `{"public_key":"83cf0f2896adee7eb8b5f01fcad3912212c437e0073e911fb90022d3e760183c8c4b450b6a0a6c3ac6a5776a2d1064510d1fec758c921cc22b0e17e63aaf4bcb5ed66304de9cf809bd274ca73bab4af5a6e9c76a4bc09e76eae8991ef5ece45a","period":3,"genesis_time":1692803367,"hash":"52db9ba70e0cc0f6eaf7803dd07447a1f5477735fd3f661792ba94600c84e971","groupHash":"f477d5c89f21a17c863a7f937c6a6d15859414d2be09cd448d4279af331c5d3e","schemeID":"bls-unchained-g1-rfc9380","metadata":{"beaconID":"quicknet"}}`,
 	},
 	DrandTestnet: {
@@ -62,6 +77,7 @@ var DrandConfigs = map[DrandEnum]DrandConf{
 			"/dnsaddr/pl-us.testnet.drand.sh/",
 		},
 		IsChained:     true,
+		ChainHash:     "84b2234fb34e835dccd048255d7ad3194b81af7d978c3bf157e3469592ae4e02",
 		ChainInfoJSON: `{"public_key":"922a2e93828ff83345bae533f5172669a26c02dc76d6bf59c80892e12ab1455c229211886f35bb56af6d5bea981024df","period":25,"genesis_time":1590445175,"hash":"84b2234fb34e835dccd048255d7ad3194b81af7d978c3bf157e3469592ae4e02","groupHash":"4dd408e5fdff9323c76a9b6f087ba8fdc5a6da907bd9217d9d10f2287d081957"}`,
 	},
 	DrandDevnet: {
@@ -74,6 +90,7 @@ var DrandConfigs = map[DrandEnum]DrandConf{
 			"/dnsaddr/dev2.drand.sh/",
 		},
 		IsChained:     true,
+		ChainHash:     "e73b7dc3c4f6a236378220c0dd6aa110eb16eed26c11259606e07ee122838d4f",
 		ChainInfoJSON: `{"public_key":"8cda589f88914aa728fd183f383980b35789ce81b274e5daee1f338b77d02566ef4d3fb0098af1f844f10f9c803c1827","period":25,"genesis_time":1595348225,"hash":"e73b7dc3c4f6a236378220c0dd6aa110eb16eed26c11259606e07ee122838d4f","groupHash":"567d4785122a5a3e75a9bc9911d7ea807dd85ff76b78dc4ff06b075712898607"}`,
 	},
 	DrandIncentinet: {
@@ -88,6 +105,7 @@ var DrandConfigs = map[DrandEnum]DrandConf{
 			"/dnsaddr/pl-sin.incentinet.drand.sh/",
 		},
 		IsChained:     true,
+		ChainHash:     "80c8b872c714f4c00fdd3daa465d5514049f457f01f85a4caf68cdcd394ba039",
 		ChainInfoJSON: `{"public_key":"8cad0c72c606ab27d36ee06de1d5b2db1faf92e447025ca37575ab3a8aac2eaae83192f846fc9e158bc738423753d000","period":30,"genesis_time":1595873820,"hash":"80c8b872c714f4c00fdd3daa465d5514049f457f01f85a4caf68cdcd394ba039","groupHash":"d9406aaed487f7af71851b4399448e311f2328923d454e971536c05398ce2d9b"}`,
 	},
 }
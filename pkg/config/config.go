@@ -43,6 +43,14 @@ type Config struct {
 	EventsConfig  *EventsConfig        `json:"events"`
 	PubsubConfig  *PubsubConfig        `json:"pubsub"`
 	FaultReporter *FaultReporterConfig `json:"faultReporter"`
+	Sync          *SyncConfig          `json:"sync"`
+	Snapshot      *SnapshotConfig      `json:"snapshot"`
+	Mining        *MiningConfig        `json:"mining"`
+	ChainCache    *ChainCacheConfig    `json:"chainCache"`
+	ChainWatch    *ChainWatchConfig    `json:"chainWatch"`
+	ParamSchema   *ParamSchemaConfig   `json:"paramSchema"`
+	MiningStats   *MiningStatsConfig   `json:"miningStats"`
+	Beacon        *BeaconConfig        `json:"beacon"`
 }
 
 // APIConfig holds all configuration options related to the api.
@@ -54,6 +62,9 @@ type APIConfig struct {
 	AccessControlAllowOrigin      []string `json:"accessControlAllowOrigin"`
 	AccessControlAllowCredentials bool     `json:"accessControlAllowCredentials"`
 	AccessControlAllowMethods     []string `json:"accessControlAllowMethods"`
+	// RestrictSigners limits the tokens issued by the auth service without admin permission to
+	// signing with the signers registered to their user.
+	RestrictSigners bool `json:"restrictSigners"`
 }
 
 type RateLimitCfg struct {
@@ -81,6 +92,9 @@ func newDefaultAPIConfig() *APIConfig {
 type DatastoreConfig struct {
 	Type string `json:"type"`
 	Path string `json:"path"`
+	// Compression is the compression of the chain blockstore tables: none, snappy or zstd.
+	// Existing tables are rewritten with it as they get compacted.
+	Compression string `json:"compression"`
 }
 
 // Validators hold the list of validation functions for each configuration
@@ -117,6 +131,24 @@ type SwarmConfig struct {
 	// ConnMgrGrace is a time duration that new connections are immune from being
 	// closed by the connection manager.
 	ConnMgrGrace Duration `json:"connMgrGrace"`
+
+	// EnableMDNS announces the node on the local network and connects to the peers found
+	// there, useful for devnets running on a LAN without bootstrap peers.
+	EnableMDNS bool `json:"enableMDNS"`
+
+	// StaticRelays are the multiaddrs, ending with /p2p/<id>, of the relays the node reserves a
+	// slot on and advertises addresses through when it isn't publicly reachable. They are
+	// probed periodically, see NetReachability.
+	StaticRelays []string `json:"staticRelays,omitempty"`
+
+	// ListenAddresses are listened on besides Address, e.g. /ip6/::/tcp/0 for dual-stack.
+	ListenAddresses []string `json:"listenAddresses,omitempty"`
+	// AnnounceAddresses, when set, are advertised to peers instead of the listen addresses.
+	AnnounceAddresses []string `json:"announceAddresses,omitempty"`
+	// NoAnnounceAddresses are multiaddrs or CIDR ranges never advertised to peers.
+	NoAnnounceAddresses []string `json:"noAnnounceAddresses,omitempty"`
+	// NoAnnouncePrivate stops advertising loopback, link local and private range addresses.
+	NoAnnouncePrivate bool `json:"noAnnouncePrivate"`
 }
 
 func newDefaultSwarmConfig() *SwarmConfig {
@@ -166,6 +198,13 @@ type WalletConfig struct {
 	PassphraseConfig PassphraseConfig `json:"passphraseConfig,omitempty"`
 	RemoteEnable     bool             `json:"remoteEnable"`
 	RemoteBackend    string           `json:"remoteBackend"`
+
+	// Backends are more remote wallets used next to the node's own, by name. Each value is an
+	// api info like RemoteBackend, a hardware signer is reached through a wallet serving it.
+	Backends map[string]string `json:"backends,omitempty"`
+	// Routes maps key addresses to the name of the backend that signs for them, "default" being
+	// the node's own wallet. Other addresses are signed by the first backend holding them.
+	Routes map[string]string `json:"routes,omitempty"`
 }
 
 type PassphraseConfig struct {
@@ -272,17 +311,38 @@ type MessagePoolConfig struct {
 	MaxNonceGap uint64 `json:"maxNonceGap"`
 	// MaxFee
 	MaxFee types.FIL `json:"maxFee"`
+	// AutoBump raises the premium of local messages that stay pending
+	AutoBump MpoolAutoBumpConfig `json:"autoBump"`
+}
+
+// MpoolAutoBumpConfig configures the replacement of stuck local messages with a higher premium.
+type MpoolAutoBumpConfig struct {
+	// Addresses whose pending messages are bumped, bumping is disabled when empty
+	Addresses []address.Address `json:"addresses,omitempty"`
+	// Epochs is the number of epochs a message stays pending before its premium is bumped
+	Epochs abi.ChainEpoch `json:"epochs"`
+	// MaxFeeCap is the highest fee cap per unit of gas a bumped message gets
+	MaxFeeCap types.FIL `json:"maxFeeCap"`
+}
+
+func newDefaultMpoolAutoBumpConfig() MpoolAutoBumpConfig {
+	return MpoolAutoBumpConfig{
+		Epochs:    10,
+		MaxFeeCap: types.MustParseFIL("10 nFIL"),
+	}
 }
 
 var DefaultMessagePoolParam = &MessagePoolConfig{
 	MaxNonceGap: 100,
 	MaxFee:      DefaultDefaultMaxFee,
+	AutoBump:    newDefaultMpoolAutoBumpConfig(),
 }
 
 func newDefaultMessagePoolConfig() *MessagePoolConfig {
 	return &MessagePoolConfig{
 		MaxNonceGap: 100,
 		MaxFee:      DefaultDefaultMaxFee,
+		AutoBump:    newDefaultMpoolAutoBumpConfig(),
 	}
 }
 
@@ -480,6 +540,30 @@ type EventsConfig struct {
 	// This will also enable the RealTimeFilterAPI and HistoricFilterAPI by default, but they can be
 	// disabled by setting their respective Disable* options in Fevm.Event.
 	EnableActorEventsAPI bool `json:"enableActorEventsAPI"`
+
+	// Webhooks are posted the chain events they subscribe to, as json.
+	Webhooks []WebhookConfig `json:"webhooks"`
+}
+
+// WebhookConfig is an url posted the head changes, message inclusions and actor events it
+// subscribes to. Failed posts are retried with a backoff.
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Secret, when set, signs each body with HMAC-SHA256, sent hex encoded in the
+	// X-Venus-Signature header.
+	Secret string `json:"secret,omitempty"`
+	// HeadChanges posts every applied and reverted tipset.
+	HeadChanges bool `json:"headChanges"`
+	// Messages posts the messages sent from or to these addresses once included in a tipset.
+	Messages []address.Address `json:"messages,omitempty"`
+	// ActorEvents posts the actor events matching EventAddresses, it needs EnableActorEventsAPI.
+	ActorEvents bool `json:"actorEvents"`
+	// EventAddresses restricts the actor events to the ones emitted by these actors, all events
+	// are posted when empty.
+	EventAddresses []address.Address `json:"eventAddresses,omitempty"`
+	// MaxRetries is the number of times a failed post is retried, doubling the wait from 1s, before
+	// it is dropped.
+	MaxRetries int `json:"maxRetries"`
 }
 
 func newFevmConfig() *FevmConfig {
@@ -500,16 +584,20 @@ func newFevmConfig() *FevmConfig {
 func newEventsConfig() *EventsConfig {
 	return &EventsConfig{
 		EnableActorEventsAPI: false,
+		Webhooks:             []WebhookConfig{},
 	}
 }
 
 type PubsubConfig struct {
 	// Run the node in bootstrap-node mode
 	Bootstrapper bool `json:"bootstrapper"`
+	// DrandGossip subscribes to the topics drand relays publish rounds on, and caches the rounds
+	// which verify, so most beacon entries don't need a request to the drand servers
+	DrandGossip bool `json:"drandGossip"`
 }
 
 func newPubsubConfig() *PubsubConfig {
-	return &PubsubConfig{Bootstrapper: false}
+	return &PubsubConfig{Bootstrapper: false, DrandGossip: true}
 }
 
 type FaultReporterConfig struct {
@@ -536,6 +624,176 @@ func newFaultReporterConfig() *FaultReporterConfig {
 	return &FaultReporterConfig{}
 }
 
+type SyncConfig struct {
+	// MinCorroboratingPeers is the number of distinct peers that must announce a chain head
+	// through hello before the node fetches and validates it, so a single peer can't feed it a
+	// fake heavier chain. Heads mined by the node itself and gossip blocks, which pass the pubsub
+	// validation first, are always synced. 1 syncs any announced head.
+	MinCorroboratingPeers int `json:"minCorroboratingPeers"`
+
+	// TrustedPeers are the peer ids whose heads are synced without corroboration, such as a
+	// checkpoint service run by the operator.
+	TrustedPeers []string `json:"trustedPeers"`
+
+	// Concurrent is the number of sync targets fetched and validated at the same time, 0 keeps
+	// the default of one. It can also be changed at runtime with SetConcurrent.
+	Concurrent int64 `json:"concurrent"`
+}
+
+func newSyncConfig() *SyncConfig {
+	return &SyncConfig{
+		MinCorroboratingPeers: 1,
+		TrustedPeers:          []string{},
+	}
+}
+
+// SnapshotConfig controls the periodic export of chain snapshots.
+type SnapshotConfig struct {
+	// Enable turns on the periodic export of CAR snapshots.
+	Enable bool `json:"enable"`
+
+	// Interval is the number of epochs between two snapshots. A snapshot is taken whenever the
+	// finalized height, finality epochs behind the head, reaches a multiple of it, and it is
+	// exported at the tipset of that height.
+	Interval abi.ChainEpoch `json:"interval"`
+
+	// RecentRoots is the number of recent state roots included in a snapshot.
+	RecentRoots abi.ChainEpoch `json:"recentRoots"`
+
+	// SkipOldMessages leaves out the messages of the tipsets older than RecentRoots.
+	SkipOldMessages bool `json:"skipOldMessages"`
+
+	// Dir is the directory snapshots are written to, the snapshots directory of the repo when empty.
+	Dir string `json:"dir"`
+
+	// Retain is the number of snapshots kept, older ones are deleted. 0 keeps all of them.
+	Retain int `json:"retain"`
+}
+
+func newSnapshotConfig() *SnapshotConfig {
+	return &SnapshotConfig{
+		Enable:          false,
+		Interval:        2880,
+		RecentRoots:     900,
+		SkipOldMessages: true,
+		Retain:          3,
+	}
+}
+
+// MiningConfig holds the options related to serving block production to miners.
+type MiningConfig struct {
+	// BeaconTimeout bounds the wait for the drand entries of the epoch being mined. When it
+	// passes, MinerGetBaseInfo fails with beacon.ErrBeaconUnavailable and the miner moves on to
+	// a later epoch. 0 waits as long as the caller does.
+	BeaconTimeout Duration `json:"beaconTimeout"`
+}
+
+func newMiningConfig() *MiningConfig {
+	return &MiningConfig{}
+}
+
+// ChainCacheConfig holds the sizes of the in-memory caches of chain data, used by both the api
+// and the syncer.
+type ChainCacheConfig struct {
+	// TipSetCacheSize is the number of recently loaded tipsets kept in memory.
+	TipSetCacheSize int `json:"tipSetCacheSize"`
+
+	// BlockHeaderCacheSize is the number of recently loaded block headers kept in memory.
+	BlockHeaderCacheSize int `json:"blockHeaderCacheSize"`
+
+	// MessageCacheSize is the number of recently loaded block message lists kept in memory.
+	MessageCacheSize int `json:"messageCacheSize"`
+}
+
+func newChainCacheConfig() *ChainCacheConfig {
+	return &ChainCacheConfig{
+		TipSetCacheSize:      10000,
+		BlockHeaderCacheSize: 4096,
+		MessageCacheSize:     2048,
+	}
+}
+
+// ChainWatchConfig controls the alerts raised when the chain looks unhealthy. Alerts are logged,
+// counted in metrics and posted to Webhook when it is set.
+type ChainWatchConfig struct {
+	// StallEpochs raises an alert when the head hasn't advanced for that many epochs, 0 disables it.
+	StallEpochs abi.ChainEpoch `json:"stallEpochs"`
+
+	// ReorgDepth raises an alert when a reorg reverts at least that many tipsets, 0 disables it.
+	ReorgDepth int `json:"reorgDepth"`
+
+	// ReferenceNodes are the api infos, as token:multiaddr, of nodes whose chain is compared
+	// with the node's once per block time, raising an alert when they differ. A node that can't
+	// be reached is retried on the next comparison.
+	ReferenceNodes []string `json:"referenceNodes"`
+
+	// Webhook is the url alerts are posted to as json.
+	Webhook string `json:"webhook"`
+}
+
+func newChainWatchConfig() *ChainWatchConfig {
+	return &ChainWatchConfig{
+		StallEpochs:    10,
+		ReorgDepth:     5,
+		ReferenceNodes: []string{},
+	}
+}
+
+// ParamSchemaConfig points to the IPLD schemas used to decode the params of messages sent to
+// actors the node has no bindings for, such as user deployed actors.
+type ParamSchemaConfig struct {
+	// Manifest is the path of a json file listing actor code cids with, for each, an IPLD schema
+	// file and the schema type of the params of each method. Empty disables it.
+	Manifest string `json:"manifest"`
+}
+
+func newParamSchemaConfig() *ParamSchemaConfig {
+	return &ParamSchemaConfig{}
+}
+
+// MiningStatsConfig lists the miners whose expected and actual wins, orphaned blocks and block
+// creation delays are tracked.
+type MiningStatsConfig struct {
+	// Miners whose blocks are tracked, tracking is disabled when empty
+	Miners []address.Address `json:"miners"`
+}
+
+func newMiningStatsConfig() *MiningStatsConfig {
+	return &MiningStatsConfig{
+		Miners: []address.Address{},
+	}
+}
+
+// BeaconConfig overrides the beacon schedule of the network, for devnets which don't want to
+// recompile to use a mock beacon or a private drand deployment.
+type BeaconConfig struct {
+	// Schedule replaces the beacon schedule of the network when not empty, it must start at or
+	// before epoch 0. It is only allowed on devnets and test networks, the node refuses to start
+	// with it on mainnet or calibnet.
+	Schedule []BeaconPointConfig `json:"schedule"`
+	// PrefetchEpochs is how many epochs ahead of the wall clock the drand rounds are fetched,
+	// so block production doesn't wait for the drand servers, 0 disables it
+	PrefetchEpochs int `json:"prefetchEpochs"`
+}
+
+// BeaconPointConfig is the beacon used from Start, exactly one of Mock, Network and Drand is set.
+type BeaconPointConfig struct {
+	Start abi.ChainEpoch `json:"start"`
+	// Mock uses a beacon deriving entries from the round number, only fit for local devnets
+	Mock bool `json:"mock,omitempty"`
+	// Network is one of the known drand networks: mainnet, quicknet, testnet, devnet or incentinet
+	Network string `json:"network,omitempty"`
+	// Drand points to a private drand deployment
+	Drand *DrandConf `json:"drand,omitempty"`
+}
+
+func newBeaconConfig() *BeaconConfig {
+	return &BeaconConfig{
+		Schedule:       []BeaconPointConfig{},
+		PrefetchEpochs: 2,
+	}
+}
+
 // NewDefaultConfig returns a config object with all the fields filled out to
 // their default values
 func NewDefaultConfig() *Config {
@@ -554,6 +812,14 @@ func NewDefaultConfig() *Config {
 		EventsConfig:  newEventsConfig(),
 		PubsubConfig:  newPubsubConfig(),
 		FaultReporter: newFaultReporterConfig(),
+		Sync:          newSyncConfig(),
+		Snapshot:      newSnapshotConfig(),
+		Mining:        newMiningConfig(),
+		ChainCache:    newChainCacheConfig(),
+		ChainWatch:    newChainWatchConfig(),
+		ParamSchema:   newParamSchemaConfig(),
+		MiningStats:   newMiningStatsConfig(),
+		Beacon:        newBeaconConfig(),
 	}
 }
 
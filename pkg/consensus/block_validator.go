@@ -80,6 +80,9 @@ type BlockValidator struct {
 	gasPirceSchedule *gas.PricesSchedule
 	// cache for validate block
 	validateBlockCache *arc.ARCCache[cid.Cid, struct{}]
+	// power claims at lookback state roots, shared by the blocks mined on the same base
+	networkPowerCache *arc.ARCCache[cid.Cid, abi.StoragePower]
+	minerPowerCache   *arc.ARCCache[minerPowerKey, abi.StoragePower]
 
 	Stmgr StateTransformer
 }
@@ -98,6 +101,8 @@ func NewBlockValidator(tv TicketValidator,
 	gasPirceSchedule *gas.PricesSchedule,
 ) *BlockValidator {
 	validateBlockCache, _ := arc.NewARC[cid.Cid, struct{}](2048)
+	networkPowerCache, _ := arc.NewARC[cid.Cid, abi.StoragePower](64)
+	minerPowerCache, _ := arc.NewARC[minerPowerKey, abi.StoragePower](2048)
 	return &BlockValidator{
 		tv:                 tv,
 		bstore:             bstore,
@@ -111,6 +116,8 @@ func NewBlockValidator(tv TicketValidator,
 		config:             config,
 		gasPirceSchedule:   gasPirceSchedule,
 		validateBlockCache: validateBlockCache,
+		networkPowerCache:  networkPowerCache,
+		minerPowerCache:    minerPowerCache,
 	}
 }
 
@@ -551,22 +558,12 @@ func (bv *BlockValidator) ValidateBlockWinner(ctx context.Context, waddr address
 		return fmt.Errorf("validating block election proof failed: %s", err)
 	}
 
-	view := bv.state.PowerStateView(lbRoot)
-	if view == nil {
-		return errors.New("power state view is null")
-	}
-
-	_, qaPower, err := view.MinerClaimedPower(ctx, blk.Miner)
+	qaPower, totalPower, err := bv.lookbackPower(ctx, lbRoot, blk.Miner)
 	if err != nil {
-		return fmt.Errorf("get miner power failed: %s", err)
-	}
-
-	tpow, err := view.PowerNetworkTotal(ctx)
-	if err != nil {
-		return fmt.Errorf("get network total power failed: %s", err)
+		return err
 	}
 
-	j := blk.ElectionProof.ComputeWinCount(qaPower, tpow.QualityAdjustedPower)
+	j := blk.ElectionProof.ComputeWinCount(qaPower, totalPower)
 	if blk.ElectionProof.WinCount != j {
 		return fmt.Errorf("miner claims wrong number of wins: miner: %d, computed: %d", blk.ElectionProof.WinCount, j)
 	}
@@ -574,6 +571,46 @@ func (bv *BlockValidator) ValidateBlockWinner(ctx context.Context, waddr address
 	return nil
 }
 
+type minerPowerKey struct {
+	root  cid.Cid
+	miner address.Address
+}
+
+// lookbackPower returns the quality adjusted power of miner and of the network at the lookback
+// state root. The claims are cached per root since every block mined at a height reads the
+// same lookback state.
+func (bv *BlockValidator) lookbackPower(ctx context.Context, lbRoot cid.Cid, miner address.Address) (abi.StoragePower, abi.StoragePower, error) {
+	key := minerPowerKey{root: lbRoot, miner: miner}
+	qaPower, minerOk := bv.minerPowerCache.Get(key)
+	totalPower, totalOk := bv.networkPowerCache.Get(lbRoot)
+	if minerOk && totalOk {
+		return qaPower, totalPower, nil
+	}
+
+	view := bv.state.PowerStateView(lbRoot)
+	if view == nil {
+		return big.Zero(), big.Zero(), errors.New("power state view is null")
+	}
+
+	if !minerOk {
+		_, qa, err := view.MinerClaimedPower(ctx, miner)
+		if err != nil {
+			return big.Zero(), big.Zero(), fmt.Errorf("get miner power failed: %s", err)
+		}
+		qaPower = qa
+		bv.minerPowerCache.Add(key, qaPower)
+	}
+	if !totalOk {
+		tpow, err := view.PowerNetworkTotal(ctx)
+		if err != nil {
+			return big.Zero(), big.Zero(), fmt.Errorf("get network total power failed: %s", err)
+		}
+		totalPower = tpow.QualityAdjustedPower
+		bv.networkPowerCache.Add(lbRoot, totalPower)
+	}
+	return qaPower, totalPower, nil
+}
+
 func (bv *BlockValidator) MinerEligibleToMine(ctx context.Context, addr address.Address, parentStateRoot cid.Cid, parentHeight abi.ChainEpoch, lookbackTS *types.TipSet) (bool, error) {
 	hmp, err := bv.minerHasMinPower(ctx, addr, lookbackTS)
 
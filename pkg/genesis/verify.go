@@ -0,0 +1,60 @@
+package genesis
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car"
+
+	"github.com/filecoin-project/venus/fixtures/assets"
+	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/filecoin-project/venus/venus-shared/utils"
+)
+
+// fixedGenesisNetworks are the networks whose genesis never changes, so a repo holding another
+// genesis was initialized for a different network. Test networks are reset with new genesis
+// files and are not checked.
+var fixedGenesisNetworks = map[types.NetworkType]struct{}{
+	types.NetworkMainnet:  {},
+	types.NetworkCalibnet: {},
+}
+
+// ExpectedGenesis returns the cid of the genesis block of the network, read from the genesis
+// shipped with venus. The bool is false for networks whose genesis isn't fixed.
+func ExpectedGenesis(networkType types.NetworkType) (cid.Cid, bool, error) {
+	if _, ok := fixedGenesisNetworks[networkType]; !ok {
+		return cid.Undef, false, nil
+	}
+
+	bs, err := assets.GetGenesis(networkType)
+	if err != nil {
+		return cid.Undef, false, err
+	}
+	header, err := car.ReadHeader(bufio.NewReader(bytes.NewReader(bs)))
+	if err != nil {
+		return cid.Undef, false, fmt.Errorf("reading genesis car header: %w", err)
+	}
+	if len(header.Roots) != 1 {
+		return cid.Undef, false, fmt.Errorf("expected one root in genesis car, got %d", len(header.Roots))
+	}
+	return header.Roots[0], true, nil
+}
+
+// VerifyGenesis fails when the genesis of the repo isn't the one of the network it's configured
+// for, so a node set up with the wrong network doesn't start syncing it.
+func VerifyGenesis(networkType types.NetworkType, genesis cid.Cid) error {
+	expected, ok, err := ExpectedGenesis(networkType)
+	if err != nil {
+		return err
+	}
+	if !ok || expected.Equals(genesis) {
+		return nil
+	}
+
+	name := utils.NetworkTypeToNetworkName(networkType)
+	return fmt.Errorf("the repo holds genesis %s but network %s has genesis %s, the repo was initialized for "+
+		"another network: initialize a new repo with --network=%s, or point the daemon at the repo of the network "+
+		"it was set up for", genesis, name, expected, name)
+}
@@ -0,0 +1,36 @@
+package genesis
+
+import (
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestExpectedGenesis(t *testing.T) {
+	tf.UnitTest(t)
+
+	// the published genesis of the networks, which the bundled genesis files must hold
+	for networkType, expect := range map[types.NetworkType]string{
+		types.NetworkMainnet:  "bafy2bzacecnamqgqmifpluoeldx7zzglxcljo6oja4vrmtj7432rphldpdmm2",
+		types.NetworkCalibnet: "bafy2bzacecyaggy24wol5ruvs6qm73gjibs2l2iyhcqmvi7r7a4ph7zx3yqd4",
+	} {
+		c, ok, err := ExpectedGenesis(networkType)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, expect, c.String())
+
+		require.NoError(t, VerifyGenesis(networkType, c))
+		other := cid.MustParse("bafy2bzacea3wsdh6y3a36tb3skempjoxqpuyompjbmfeyf34fi3uy6uue42v4")
+		require.ErrorContains(t, VerifyGenesis(networkType, other), "initialized for another network")
+	}
+
+	// test networks are reset with new genesis files and aren't checked
+	_, ok, err := ExpectedGenesis(types.NetworkForce)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, VerifyGenesis(types.NetworkForce, cid.Undef))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ipfs/go-datastore"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/venus/pkg/repo"
 	"github.com/filecoin-project/venus/venus-shared/types"
 )
@@ -34,6 +35,11 @@ type MpoolConfig struct {
 	ReplaceByFeeRatio      types.Percent
 	PruneCooldown          time.Duration
 	GasLimitOverestimation float64
+	// PendingTTL is the number of epochs the messages included in every tipset can outbid a pending
+	// message before it is dropped, 0 keeps messages until they are pruned
+	PendingTTL abi.ChainEpoch
+	// ExpireLocal applies PendingTTL to the messages of local and priority addresses too
+	ExpireLocal bool
 }
 
 func (mc *MpoolConfig) Clone() *MpoolConfig {
@@ -83,6 +89,9 @@ func validateConfg(cfg *MpoolConfig) error {
 	if cfg.GasLimitOverestimation < 1 {
 		return fmt.Errorf("'GasLimitOverestimation' cannot be less than 1")
 	}
+	if cfg.PendingTTL < 0 {
+		return fmt.Errorf("'PendingTTL' cannot be negative")
+	}
 	return nil
 }
 
@@ -0,0 +1,106 @@
+package messagepool
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/filecoin-project/go-state-types/big"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+const (
+	defaultGasMarketTipsets = 10
+	// maxGasMarketTipsets keeps the walk within the price cache
+	maxGasMarketTipsets = 40
+)
+
+// GasMarket returns the distribution of the gas premiums of the pending messages and of the
+// messages included in the last tipsets, and the base fees of those tipsets.
+func (mp *MessagePool) GasMarket(ctx context.Context, tipsets int, cache *GasPriceCache) (*types.GasMarket, error) {
+	if tipsets <= 0 {
+		tipsets = defaultGasMarketTipsets
+	}
+	if tipsets > maxGasMarketTipsets {
+		return nil, fmt.Errorf("at most %d tipsets can be looked back, %d requested", maxGasMarketTipsets, tipsets)
+	}
+
+	pending, head := mp.Pending(ctx)
+	pendingPrices := make([]big.Int, 0, len(pending))
+	for _, m := range pending {
+		pendingPrices = append(pendingPrices, m.Message.GasPremium)
+	}
+
+	nextBaseFee, err := mp.api.ChainComputeBaseFee(ctx, head)
+	if err != nil {
+		return nil, fmt.Errorf("computing next base fee: %w", err)
+	}
+
+	market := &types.GasMarket{
+		Height:   head.Height(),
+		Pending:  gasPremiumStats(pendingPrices),
+		BaseFees: []types.BaseFeeAt{{Height: head.Height() + 1, BaseFee: nextBaseFee}},
+	}
+
+	var included []big.Int
+	ts := head
+	for {
+		meta, err := cache.GetTSGasStats(ctx, mp.api, ts)
+		if err != nil {
+			return nil, err
+		}
+
+		var low big.Int
+		for i, m := range meta {
+			included = append(included, m.Price)
+			if i == 0 || m.Price.LessThan(low) {
+				low = m.Price
+			}
+		}
+		if len(meta) > 0 {
+			market.MinIncluded = append(market.MinIncluded, low)
+		}
+		// the messages of a tipset pay the base fee its blocks carry as parent base fee
+		market.BaseFees = append(market.BaseFees, types.BaseFeeAt{Height: ts.Height(), BaseFee: ts.Blocks()[0].ParentBaseFee})
+		market.Tipsets++
+
+		if market.Tipsets >= tipsets || ts.Height() == 0 {
+			break
+		}
+		ts, err = mp.api.LoadTipSet(ctx, ts.Parents())
+		if err != nil {
+			return nil, err
+		}
+	}
+	market.Included = gasPremiumStats(included)
+
+	// walked from the head down, report oldest first
+	slices.Reverse(market.MinIncluded)
+	slices.Reverse(market.BaseFees)
+	return market, nil
+}
+
+func gasPremiumStats(prices []big.Int) types.GasPremiumStats {
+	stats := types.GasPremiumStats{Messages: len(prices)}
+	if len(prices) == 0 {
+		zero := big.Zero()
+		stats.Min, stats.P25, stats.P50, stats.P75, stats.P90, stats.Max = zero, zero, zero, zero, zero, zero
+		return stats
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LessThan(prices[j])
+	})
+	at := func(q float64) big.Int {
+		return prices[int(q*float64(len(prices)-1))]
+	}
+	stats.Min = prices[0]
+	stats.P25 = at(0.25)
+	stats.P50 = at(0.5)
+	stats.P75 = at(0.75)
+	stats.P90 = at(0.9)
+	stats.Max = prices[len(prices)-1]
+	return stats
+}
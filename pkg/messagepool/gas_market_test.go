@@ -0,0 +1,83 @@
+package messagepool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestGasPremiumStats(t *testing.T) {
+	tf.UnitTest(t)
+
+	stats := gasPremiumStats(nil)
+	assert.Equal(t, 0, stats.Messages)
+	assert.True(t, stats.Max.IsZero())
+
+	var prices []big.Int
+	for i := 100; i > 0; i-- {
+		prices = append(prices, big.NewInt(int64(i)))
+	}
+	stats = gasPremiumStats(prices)
+	assert.Equal(t, 100, stats.Messages)
+	assert.Equal(t, big.NewInt(1), stats.Min)
+	assert.Equal(t, big.NewInt(25), stats.P25)
+	assert.Equal(t, big.NewInt(50), stats.P50)
+	assert.Equal(t, big.NewInt(75), stats.P75)
+	assert.Equal(t, big.NewInt(90), stats.P90)
+	assert.Equal(t, big.NewInt(100), stats.Max)
+}
+
+func TestGasMarketBaseFees(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	mp, tma := makeTestMpool()
+	tma.baseFee = big.NewInt(5000)
+
+	w := newWallet(t)
+	from, err := w.NewAddress(ctx, address.SECP256K1)
+	require.NoError(t, err)
+
+	// the blocks at 1 to 4 carry increasing parent base fees, the ones at 2 and 4 hold a message
+	premiums := map[abi.ChainEpoch]uint64{2: 3, 4: 7}
+	for h := 1; h <= 4; h++ {
+		blk := mkBlock(tma.tipsets[len(tma.tipsets)-1], 1, 1)
+		blk.ParentBaseFee = big.NewInt(int64(h * 1000))
+		tma.tipsets = append(tma.tipsets, mkTipSet(blk))
+		if premium, ok := premiums[abi.ChainEpoch(h)]; ok {
+			tma.setBlockMessages(blk, makeTestMessage(w, from, from, uint64(h), 1000, premium))
+		}
+		tma.applyBlock(t, blk)
+	}
+
+	market, err := mp.GasMarket(ctx, 3, NewGasPriceCache())
+	require.NoError(t, err)
+	require.Equal(t, abi.ChainEpoch(4), market.Height)
+	require.Equal(t, 3, market.Tipsets)
+	// the head and its parents pay their own parent base fee, the next tipset the computed one
+	require.Equal(t, []types.BaseFeeAt{
+		{Height: 2, BaseFee: big.NewInt(2000)},
+		{Height: 3, BaseFee: big.NewInt(3000)},
+		{Height: 4, BaseFee: big.NewInt(4000)},
+		{Height: 5, BaseFee: big.NewInt(5000)},
+	}, market.BaseFees)
+	require.Equal(t, 2, market.Included.Messages)
+	require.Equal(t, []big.Int{big.NewInt(3), big.NewInt(7)}, market.MinIncluded)
+
+	// the walk stops at genesis
+	market, err = mp.GasMarket(ctx, 10, NewGasPriceCache())
+	require.NoError(t, err)
+	require.Equal(t, 5, market.Tipsets)
+	require.Len(t, market.BaseFees, 6)
+	require.Equal(t, abi.ChainEpoch(0), market.BaseFees[0].Height)
+	require.Equal(t, tma.tipsets[0].Blocks()[0].ParentBaseFee, market.BaseFees[0].BaseFee)
+	require.Equal(t, big.NewInt(1000), market.BaseFees[1].BaseFee)
+}
@@ -201,6 +201,11 @@ type msgSet struct {
 	msgs          map[uint64]*types.SignedMessage
 	nextNonce     uint64
 	requiredFunds *stdbig.Int
+	// addedAt is the head height when the message with each nonce entered the pool
+	addedAt map[uint64]abi.ChainEpoch
+	// outbidSince is the height of the first applied tipset since which every tipset included
+	// only messages paying more than the message with each nonce
+	outbidSince map[uint64]abi.ChainEpoch
 }
 
 func newMsgSet(nonce uint64) *msgSet {
@@ -208,6 +213,8 @@ func newMsgSet(nonce uint64) *msgSet {
 		msgs:          make(map[uint64]*types.SignedMessage),
 		nextNonce:     nonce,
 		requiredFunds: stdbig.NewInt(0),
+		addedAt:       make(map[uint64]abi.ChainEpoch),
+		outbidSince:   make(map[uint64]abi.ChainEpoch),
 	}
 }
 
@@ -320,6 +327,9 @@ func (ms *msgSet) add(m *types.SignedMessage, mp *MessagePool, strict, untrusted
 
 	ms.nextNonce = nextNonce
 	ms.msgs[m.Message.Nonce] = m
+	if mp.curTS != nil {
+		ms.addedAt[m.Message.Nonce] = mp.curTS.Height()
+	}
 	ms.requiredFunds.Add(ms.requiredFunds, m.Message.RequiredFunds().Int)
 	// ms.requiredFunds.Add(ms.requiredFunds, m.Message.Value.Int)
 
@@ -343,6 +353,8 @@ func (ms *msgSet) rm(nonce uint64, applied bool) {
 	ms.requiredFunds.Sub(ms.requiredFunds, m.Message.RequiredFunds().Int)
 	// ms.requiredFunds.Sub(ms.requiredFunds, m.Message.Value.Int)
 	delete(ms.msgs, nonce)
+	delete(ms.addedAt, nonce)
+	delete(ms.outbidSince, nonce)
 
 	// adjust next nonce
 	if applied {
@@ -1312,6 +1324,45 @@ func (mp *MessagePool) PendingFor(ctx context.Context, a address.Address) ([]*ty
 	return mp.pendingFor(ctx, a), mp.curTS
 }
 
+// PendingFunds returns the value and maximum gas fees of the pending messages of a.
+func (mp *MessagePool) PendingFunds(ctx context.Context, a address.Address) (big.Int, error) {
+	mp.lk.RLock()
+	defer mp.lk.RUnlock()
+
+	mset, ok, err := mp.getPendingMset(ctx, a)
+	if err != nil {
+		return big.Zero(), err
+	}
+	if !ok {
+		return big.Zero(), nil
+	}
+	return big.Int{Int: new(stdbig.Int).Set(mset.requiredFunds)}, nil
+}
+
+// PendingOlderThan returns the pending messages of a that entered the pool, or last replaced the
+// message with the same nonce, at least epochs before the current head.
+func (mp *MessagePool) PendingOlderThan(ctx context.Context, a address.Address, epochs abi.ChainEpoch) []*types.SignedMessage {
+	mp.curTSLk.RLock()
+	defer mp.curTSLk.RUnlock()
+
+	mp.lk.RLock()
+	defer mp.lk.RUnlock()
+
+	mset, ok, err := mp.getPendingMset(ctx, a)
+	if err != nil || !ok || mp.curTS == nil {
+		return nil
+	}
+
+	var out []*types.SignedMessage
+	for _, m := range mset.toSlice() {
+		addedAt, ok := mset.addedAt[m.Message.Nonce]
+		if ok && mp.curTS.Height()-addedAt >= epochs {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
 func (mp *MessagePool) pendingFor(ctx context.Context, a address.Address) []*types.SignedMessage {
 	mset, ok, err := mp.getPendingMset(ctx, a)
 	if err != nil {
@@ -1391,9 +1442,11 @@ func (mp *MessagePool) HeadChange(ctx context.Context, revert []*types.TipSet, a
 		}
 	}
 
+	paid := make([]includedFees, 0, len(apply))
 	for _, ts := range apply {
 		mp.curTS = ts
 
+		fees := includedFees{height: ts.Height(), baseFee: ts.Blocks()[0].ParentBaseFee}
 		for _, b := range ts.Blocks() {
 			bmsgs, smsgs, err := mp.api.MessagesForBlock(ctx, b)
 			if err != nil {
@@ -1406,13 +1459,18 @@ func (mp *MessagePool) HeadChange(ctx context.Context, revert []*types.TipSet, a
 			for _, msg := range smsgs {
 				rm(msg.Message.From, msg.Message.Nonce)
 				maybeRepub(msg.Cid())
+				fees.add(&msg.Message)
 			}
 
 			for _, msg := range bmsgs {
 				rm(msg.From, msg.Nonce)
 				maybeRepub(msg.Cid())
+				fees.add(msg)
 			}
 		}
+		if fees.included {
+			paid = append(paid, fees)
+		}
 	}
 
 	if repubTrigger {
@@ -1430,6 +1488,10 @@ func (mp *MessagePool) HeadChange(ctx context.Context, revert []*types.TipSet, a
 		}
 	}
 
+	if len(apply) > 0 {
+		mp.expirePending(ctx, mp.curTS.Height(), paid)
+	}
+
 	if len(revert) > 0 && futureDebug {
 		mp.lk.RLock()
 		msgs, ts := mp.allPending(ctx)
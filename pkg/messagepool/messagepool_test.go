@@ -19,6 +19,7 @@ import (
 	"github.com/filecoin-project/go-state-types/network"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/stretchr/testify/assert"
 
@@ -690,6 +691,138 @@ func TestPruningSimple(t *testing.T) {
 	}
 }
 
+func TestExpirePending(t *testing.T) {
+	tf.UnitTest(t)
+
+	tma := newTestMpoolAPI()
+	ds := datastore.NewMapDatastore()
+
+	mp, err := New(context.Background(), tma, nil, ds, config.NewDefaultConfig().NetworkParams, config.DefaultMessagePoolParam, "mptest", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp.cfg.PendingTTL = 3
+
+	wallets := make([]*wallet.Wallet, 4)
+	addrs := make([]address.Address, 4)
+	for i := range wallets {
+		wallets[i] = newWallet(t)
+		addrs[i], err = wallets[i].NewAddress(context.Background(), address.SECP256K1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tma.setBalance(addrs[i], 1) // in FIL
+	}
+	// a1 is local, a2 is outbid, a3 is old but competitive and a4 sends the included messages
+	a1, a2, a3, a4 := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	gasLimit := gasguess.Costs[gasguess.CostKey{Code: builtin2.StorageMarketActorCodeID, M: 2}]
+	for i := 0; i < 5; i++ {
+		m := makeTestMessage(wallets[0], a1, a2, uint64(i), gasLimit, uint64(i+1))
+		if _, err := mp.Push(context.TODO(), m); err != nil {
+			t.Fatal(err)
+		}
+
+		m = makeTestMessage(wallets[1], a2, a1, uint64(i), gasLimit, uint64(i+1))
+		mustAdd(t, mp, m)
+	}
+	mustAdd(t, mp, makeTestMessage(wallets[2], a3, a1, 0, gasLimit, 20))
+
+	// every block includes a message paying a premium of 10
+	nonce := uint64(0)
+	applyBlock := func() {
+		blk := tma.nextBlock()
+		tma.setBlockMessages(blk, makeTestMessage(wallets[3], a4, a1, nonce, gasLimit, 10))
+		nonce++
+		tma.applyBlock(t, blk)
+	}
+
+	for i := 0; i < 3; i++ {
+		applyBlock()
+	}
+	pending, _ := mp.Pending(context.TODO())
+	if len(pending) != 11 {
+		t.Fatalf("expected 11 pending messages before the ttl, but got %d instead", len(pending))
+	}
+
+	applyBlock()
+	pending, _ = mp.Pending(context.TODO())
+	if len(pending) != 6 {
+		t.Fatalf("expected 6 pending messages after the ttl, but got %d instead", len(pending))
+	}
+	for _, m := range pending {
+		if m.Message.From == a2 {
+			t.Fatalf("expected the outbid messages of %s to expire", a2)
+		}
+	}
+
+	mp.cfg.ExpireLocal = true
+	applyBlock()
+	pending, _ = mp.Pending(context.TODO())
+	if len(pending) != 1 || pending[0].Message.From != a3 {
+		t.Fatalf("expected only the competitive message of %s to be pending, but got %d pending", a3, len(pending))
+	}
+
+	res, err := mp.localMsgs.Query(context.TODO(), query.Query{KeysOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := res.Rest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected expired local messages to be deleted from the store, but got %d", len(entries))
+	}
+}
+
+func TestPendingFunds(t *testing.T) {
+	tf.UnitTest(t)
+
+	tma := newTestMpoolAPI()
+	ds := datastore.NewMapDatastore()
+
+	mp, err := New(context.Background(), tma, nil, ds, config.NewDefaultConfig().NetworkParams, config.DefaultMessagePoolParam, "mptest", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := newWallet(t)
+	a1, err := w.NewAddress(context.Background(), address.SECP256K1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2, err := w.NewAddress(context.Background(), address.SECP256K1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tma.setBalance(a1, 1) // in FIL
+
+	funds, err := mp.PendingFunds(context.TODO(), a1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !funds.IsZero() {
+		t.Fatalf("expected no pending funds, got %s", funds)
+	}
+
+	gasLimit := gasguess.Costs[gasguess.CostKey{Code: builtin2.StorageMarketActorCodeID, M: 2}]
+	expected := tbig.Zero()
+	for i := 0; i < 3; i++ {
+		m := makeTestMessage(w, a1, a2, uint64(i), gasLimit, uint64(i+1))
+		mustAdd(t, mp, m)
+		expected = tbig.Add(expected, m.Message.RequiredFunds())
+	}
+
+	funds, err = mp.PendingFunds(context.TODO(), a1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !funds.Equals(expected) {
+		t.Fatalf("expected pending funds %s, got %s", expected, funds)
+	}
+}
+
 func TestLoadLocal(t *testing.T) {
 	tf.UnitTest(t)
 
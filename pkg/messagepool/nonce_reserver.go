@@ -0,0 +1,192 @@
+package messagepool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/venus/venus-shared/types"
+	"github.com/ipfs/go-datastore"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+const (
+	dsKeyActorNonce    = "ActorNextNonce"
+	dsKeyReservedNonce = "ActorReservedNonce"
+)
+
+// NonceReserver assigns nonces per sender. A nonce is reserved for the duration of a push and
+// no other nonce is handed out for the same sender until the reservation is committed or
+// released, so concurrent pushes can not pick the same nonce and a failed push does not leave
+// a gap. Reservations are persisted so a restart in the middle of a push neither reuses a nonce
+// that reached the message pool nor skips one that did not.
+type NonceReserver struct {
+	mpool  MpoolNonceAPI
+	ds     datastore.Batching
+	locker *MpoolLocker
+}
+
+func NewNonceReserver(mpool MpoolNonceAPI, ds datastore.Batching) *NonceReserver {
+	return &NonceReserver{
+		mpool:  mpool,
+		ds:     ds,
+		locker: NewMpoolLocker(),
+	}
+}
+
+// NonceReservation is a nonce reserved for a sender, it must be either committed or released.
+type NonceReservation struct {
+	Addr  address.Address
+	Nonce uint64
+
+	nr   *NonceReserver
+	once sync.Once
+	done func()
+}
+
+// Reserve waits for outstanding reservations of addr to finish and reserves the next nonce.
+func (nr *NonceReserver) Reserve(ctx context.Context, addr address.Address) (*NonceReservation, error) {
+	done, err := nr.locker.TakeLock(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("taking nonce lock: %w", err)
+	}
+
+	nonce, err := nr.nextNonce(ctx, addr)
+	if err != nil {
+		done()
+		return nil, fmt.Errorf("failed to create nonce: %w", err)
+	}
+
+	if err := nr.putNonce(ctx, nr.dstoreKey(dsKeyReservedNonce, addr), nonce); err != nil {
+		done()
+		return nil, fmt.Errorf("failed to save nonce reservation: %w", err)
+	}
+
+	return &NonceReservation{
+		Addr:  addr,
+		Nonce: nonce,
+		nr:    nr,
+		done:  done,
+	}, nil
+}
+
+// Commit marks the reserved nonce as used, the next reservation gets the following nonce.
+func (r *NonceReservation) Commit(ctx context.Context) error {
+	var err error
+	r.once.Do(func() {
+		defer r.done()
+		if err = r.nr.putNonce(ctx, r.nr.dstoreKey(dsKeyActorNonce, r.Addr), r.Nonce+1); err != nil {
+			err = fmt.Errorf("failed to save nonce: %w", err)
+			return
+		}
+		err = r.nr.clearReservation(ctx, r.Addr)
+	})
+	return err
+}
+
+// Release gives the reserved nonce back, the next reservation gets the same nonce again.
+func (r *NonceReservation) Release(ctx context.Context) error {
+	var err error
+	r.once.Do(func() {
+		defer r.done()
+		err = r.nr.clearReservation(ctx, r.Addr)
+	})
+	return err
+}
+
+// nextNonce gets the next nonce for the given address.
+// If there is no nonce in the datastore, gets the nonce from the message pool.
+func (nr *NonceReserver) nextNonce(ctx context.Context, addr address.Address) (uint64, error) {
+	// Nonces used to be created by the mempool and we need to support nodes
+	// that have mempool nonces, so first check the mempool for a nonce for
+	// this address. Note that the mempool returns the actor state's nonce
+	// by default.
+	nonce, err := nr.mpool.GetNonce(ctx, addr, types.EmptyTSK)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get nonce from mempool: %w", err)
+	}
+
+	// A reservation left behind means the node stopped in the middle of a push. If the
+	// message reached the mempool its nonce is now taken, otherwise it can be reused.
+	reserved, found, err := nr.getNonce(ctx, nr.dstoreKey(dsKeyReservedNonce, addr))
+	if err != nil {
+		return 0, err
+	}
+	if found {
+		if nonce > reserved {
+			if err := nr.putNonce(ctx, nr.dstoreKey(dsKeyActorNonce, addr), reserved+1); err != nil {
+				return 0, fmt.Errorf("failed to save nonce: %w", err)
+			}
+		} else {
+			log.Warnf("reusing nonce %d reserved for %s by an unfinished push", reserved, addr)
+		}
+		if err := nr.clearReservation(ctx, addr); err != nil {
+			return 0, err
+		}
+	}
+
+	// Get the next nonce for this address from the datastore
+	dsNonce, found, err := nr.getNonce(ctx, nr.dstoreKey(dsKeyActorNonce, addr))
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		// If a nonce for this address hasn't yet been created in the
+		// datastore, just use the nonce from the mempool
+		return nonce, nil
+	}
+
+	// The message pool nonce should be <= than the datastore nonce
+	if nonce <= dsNonce {
+		nonce = dsNonce
+	} else {
+		log.Warnf("mempool nonce was larger than datastore nonce (%d > %d)", nonce, dsNonce)
+	}
+
+	return nonce, nil
+}
+
+func (nr *NonceReserver) getNonce(ctx context.Context, key datastore.Key) (uint64, bool, error) {
+	dsNonceBytes, err := nr.ds.Get(ctx, key)
+	switch {
+	case errors.Is(err, datastore.ErrNotFound):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, fmt.Errorf("failed to get nonce from datastore: %w", err)
+	}
+
+	maj, nonce, err := cbg.CborReadHeader(bytes.NewReader(dsNonceBytes))
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse nonce from datastore: %w", err)
+	}
+	if maj != cbg.MajUnsignedInt {
+		return 0, false, fmt.Errorf("bad cbor type parsing nonce from datastore")
+	}
+	return nonce, true, nil
+}
+
+func (nr *NonceReserver) putNonce(ctx context.Context, key datastore.Key, nonce uint64) error {
+	buf := bytes.Buffer{}
+	_, err := buf.Write(cbg.CborEncodeMajorType(cbg.MajUnsignedInt, nonce))
+	if err != nil {
+		return fmt.Errorf("failed to marshall nonce: %w", err)
+	}
+	if err := nr.ds.Put(ctx, key, buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write nonce to datastore: %w", err)
+	}
+	return nil
+}
+
+func (nr *NonceReserver) clearReservation(ctx context.Context, addr address.Address) error {
+	if err := nr.ds.Delete(ctx, nr.dstoreKey(dsKeyReservedNonce, addr)); err != nil {
+		return fmt.Errorf("failed to clear nonce reservation: %w", err)
+	}
+	return nil
+}
+
+func (nr *NonceReserver) dstoreKey(prefix string, addr address.Address) datastore.Key {
+	return datastore.KeyWithNamespaces([]string{prefix, addr.String()})
+}
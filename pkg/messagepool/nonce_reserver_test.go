@@ -0,0 +1,107 @@
+// stm: #unit
+package messagepool
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/testutil"
+)
+
+func TestNonceReserverConcurrentReserve(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	mpool := newMockMpool()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	nr := NewNonceReserver(mpool, ds)
+	addr := testutil.AddressProvider()(t)
+
+	const pushes = 20
+	var (
+		wg     sync.WaitGroup
+		lk     sync.Mutex
+		nonces = map[uint64]struct{}{}
+	)
+	for i := 0; i < pushes; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r, err := nr.Reserve(ctx, addr)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			lk.Lock()
+			nonces[r.Nonce] = struct{}{}
+			lk.Unlock()
+
+			// every other push fails and gives its nonce back
+			if i%2 == 0 {
+				assert.NoError(t, r.Release(ctx))
+				return
+			}
+			assert.NoError(t, r.Commit(ctx))
+		}(i)
+	}
+	wg.Wait()
+
+	// released nonces are handed out again, so no gaps are left behind
+	for n := range nonces {
+		require.Less(t, n, uint64(pushes/2+1))
+	}
+
+	r, err := nr.Reserve(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(pushes/2), r.Nonce)
+	require.NoError(t, r.Commit(ctx))
+	// finishing a reservation twice is a no-op
+	require.NoError(t, r.Release(ctx))
+}
+
+func TestNonceReserverRestart(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	mpool := newMockMpool()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	addr := testutil.AddressProvider()(t)
+
+	nr := NewNonceReserver(mpool, ds)
+	r, err := nr.Reserve(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), r.Nonce)
+	require.NoError(t, r.Commit(ctx))
+
+	// the node stops before the push finishes and the message never reached the mempool
+	r, err = nr.Reserve(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), r.Nonce)
+
+	nr = NewNonceReserver(mpool, ds)
+	r, err = nr.Reserve(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), r.Nonce)
+
+	// the node stops after the message reached the mempool, but before committing
+	mpool.setNonce(addr, 2)
+	nr = NewNonceReserver(mpool, ds)
+	r, err = nr.Reserve(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), r.Nonce)
+	require.NoError(t, r.Commit(ctx))
+
+	// the mempool forgot about the message, the datastore still remembers the nonce was used
+	mpool.setNonce(addr, 0)
+	nr = NewNonceReserver(mpool, ds)
+	r, err = nr.Reserve(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), r.Nonce)
+}
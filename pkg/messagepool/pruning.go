@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/venus/venus-shared/types"
 	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
 )
 
 func (mp *MessagePool) pruneExcessMessages() error {
@@ -117,3 +120,111 @@ keepLoop:
 
 	return nil
 }
+
+// includedFees is the least the messages included in an applied tipset paid.
+type includedFees struct {
+	height  abi.ChainEpoch
+	baseFee abi.TokenAmount
+	// premium is the lowest gas premium the included messages paid at the base fee
+	premium  abi.TokenAmount
+	included bool
+}
+
+// effectivePremium is the gas premium m pays at baseFee, limited by its fee cap.
+func effectivePremium(m *types.Message, baseFee abi.TokenAmount) abi.TokenAmount {
+	return big.Min(m.GasPremium, big.Sub(m.GasFeeCap, baseFee))
+}
+
+func (f *includedFees) add(m *types.Message) {
+	premium := effectivePremium(m, f.baseFee)
+	if !f.included || premium.LessThan(f.premium) {
+		f.premium = premium
+	}
+	f.included = true
+}
+
+// outbids tells whether all the messages included in the tipset paid more than m would have.
+func (f *includedFees) outbids(m *types.Message) bool {
+	return m.GasFeeCap.LessThan(f.baseFee) || effectivePremium(m, f.baseFee).LessThan(f.premium)
+}
+
+// expirePending drops the messages which every tipset applied for PendingTTL epochs outbid, that
+// is whose fee cap stayed below the base fee or whose premium stayed below the ones of all the
+// included messages, along with the later messages of the same sender which can't be included
+// without them. The messages of priority and local addresses only expire with ExpireLocal, and
+// the expired local messages are then deleted from the local message store so they are not
+// loaded again on restart.
+func (mp *MessagePool) expirePending(ctx context.Context, height abi.ChainEpoch, paid []includedFees) {
+	cfg := mp.GetConfig()
+	if cfg.PendingTTL <= 0 {
+		return
+	}
+
+	mp.lk.Lock()
+	defer mp.lk.Unlock()
+
+	protected := make(map[address.Address]struct{})
+	if !cfg.ExpireLocal {
+		for _, actor := range cfg.PriorityAddrs {
+			pk, err := mp.resolveToKey(ctx, actor)
+			if err != nil {
+				log.Debugf("expirePending failed to resolve priority address: %s", err)
+			}
+
+			protected[pk] = struct{}{}
+		}
+		mp.forEachLocal(ctx, func(ctx context.Context, actor address.Address) {
+			protected[actor] = struct{}{}
+		})
+	}
+
+	expired := make(map[address.Address]uint64)
+	mp.forEachPending(func(actor address.Address, mset *msgSet) {
+		// protected messages are tracked too so they expire in time when ExpireLocal is turned on
+		_, keep := protected[actor]
+		for nonce, m := range mset.msgs {
+			for i := range paid {
+				if !paid[i].outbids(&m.Message) {
+					delete(mset.outbidSince, nonce)
+				} else if _, ok := mset.outbidSince[nonce]; !ok {
+					mset.outbidSince[nonce] = paid[i].height
+				}
+			}
+
+			if keep {
+				continue
+			}
+			since, ok := mset.outbidSince[nonce]
+			if !ok || height-since < cfg.PendingTTL {
+				continue
+			}
+			if first, ok := expired[actor]; !ok || nonce < first {
+				expired[actor] = nonce
+			}
+		}
+	})
+
+	count := 0
+	for actor, first := range expired {
+		mset, ok, err := mp.getPendingMset(ctx, actor)
+		if err != nil || !ok {
+			continue
+		}
+		_, local := mp.localAddrs[actor]
+		for nonce, m := range mset.msgs {
+			if nonce < first {
+				continue
+			}
+			if local {
+				if err := mp.localMsgs.Delete(ctx, datastore.NewKey(string(m.Cid().Bytes()))); err != nil {
+					log.Warnf("error deleting expired local message: %s", err)
+				}
+			}
+			mp.remove(ctx, actor, nonce, false)
+			count++
+		}
+	}
+	if count > 0 {
+		log.Infof("expired %d messages outbid for more than %d epochs", count, cfg.PendingTTL)
+	}
+}
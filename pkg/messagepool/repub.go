@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/big"
 	"github.com/ipfs/go-cid"
 
 	"github.com/filecoin-project/venus/pkg/constants"
@@ -29,6 +30,7 @@ func (mp *MessagePool) republishPendingMessages(ctx context.Context) error {
 		return fmt.Errorf("computing basefee: %v", err)
 	}
 	baseFeeLowerBound := getBaseFeeLowerBound(baseFee, baseFeeLowerBoundFactor)
+	minFeeCap := repubMinFeeCap(baseFee, ts.Blocks()[0].ParentBaseFee, baseFeeLowerBound)
 
 	pending := make(map[address.Address]map[uint64]*types.SignedMessage)
 
@@ -79,6 +81,8 @@ func (mp *MessagePool) republishPendingMessages(ctx context.Context) error {
 	gasLimit := int64(constants.BlockGasLimit)
 	minGas := int64(gasguess.MinGas)
 	var msgs []*types.SignedMessage
+	// skipped counts the messages left out because their fee cap can't pay the base fee
+	skipped := 0
 
 LOOP:
 	for i := 0; i < len(chains); {
@@ -102,10 +106,11 @@ LOOP:
 
 		// does it fit in a block?
 		if chain.gasLimit <= gasLimit {
-			// check the baseFee lower bound -- only republish messages that can be included in the chain
-			// within the next 20 blocks.
+			// check the fee cap floor -- only republish messages that can be included in the chain
+			// given where the base fee is heading.
 			for _, m := range chain.msgs {
-				if m.Message.GasFeeCap.LessThan(baseFeeLowerBound) {
+				if m.Message.GasFeeCap.LessThan(minFeeCap) {
+					skipped += len(chain.msgs)
 					chain.Invalidate()
 					continue LOOP
 				}
@@ -134,6 +139,9 @@ LOOP:
 		msgs = msgs[:repubMsgLimit]
 	}
 
+	if skipped > 0 {
+		log.Infof("not republishing %d messages with a fee cap below %s, base fee is %s", skipped, minFeeCap, baseFee)
+	}
 	log.Infof("republishing %d messages", len(msgs))
 	for _, m := range msgs {
 		buf := new(bytes.Buffer)
@@ -182,3 +190,14 @@ LOOP:
 
 	return nil
 }
+
+// repubMinFeeCap returns the lowest fee cap a pending message needs to be republished. While the
+// base fee is rising, a message that can't pay the current one won't be included before the trend
+// turns, so gossiping it only wastes bandwidth. Otherwise messages that can be included within the
+// next 20 blocks, as the base fee keeps falling, are still republished.
+func repubMinFeeCap(baseFee, parentBaseFee, baseFeeLowerBound big.Int) big.Int {
+	if baseFee.GreaterThan(parentBaseFee) {
+		return baseFee
+	}
+	return baseFeeLowerBound
+}
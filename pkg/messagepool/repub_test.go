@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/big"
 	builtin2 "github.com/filecoin-project/specs-actors/v2/actors/builtin"
 	"github.com/ipfs/go-datastore"
 
@@ -68,3 +69,20 @@ func TestRepubMessages(t *testing.T) {
 		t.Fatalf("expected to have published 20 messages, but got %d instead", tma.published)
 	}
 }
+
+func TestRepubMinFeeCap(t *testing.T) {
+	tf.UnitTest(t)
+
+	lowerBound := big.NewInt(10)
+	// rising base fee, messages must pay the current one
+	if fc := repubMinFeeCap(big.NewInt(120), big.NewInt(100), lowerBound); !fc.Equals(big.NewInt(120)) {
+		t.Fatalf("expected fee cap floor 120 while the base fee rises, got %s", fc)
+	}
+	// flat or falling base fee, messages that may become includable are kept
+	if fc := repubMinFeeCap(big.NewInt(100), big.NewInt(100), lowerBound); !fc.Equals(lowerBound) {
+		t.Fatalf("expected fee cap floor %s while the base fee is flat, got %s", lowerBound, fc)
+	}
+	if fc := repubMinFeeCap(big.NewInt(80), big.NewInt(100), lowerBound); !fc.Equals(lowerBound) {
+		t.Fatalf("expected fee cap floor %s while the base fee falls, got %s", lowerBound, fc)
+	}
+}
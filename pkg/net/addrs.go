@@ -0,0 +1,67 @@
+package net
+
+import (
+	"fmt"
+	"net"
+
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// AddrsFactory returns the function picking the addresses the host advertises to peers.
+// Announce replaces the addresses the host listens on when it isn't empty. NoAnnounce holds
+// multiaddrs or CIDR ranges which are never advertised, and noPrivate also drops loopback,
+// link local and private range addresses.
+func AddrsFactory(announce, noAnnounce []string, noPrivate bool) (func([]ma.Multiaddr) []ma.Multiaddr, error) {
+	announced := make([]ma.Multiaddr, 0, len(announce))
+	for _, s := range announce {
+		a, err := ma.NewMultiaddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid announce address %s: %w", s, err)
+		}
+		announced = append(announced, a)
+	}
+
+	excluded := make(map[string]struct{})
+	var ranges []*net.IPNet
+	for _, s := range noAnnounce {
+		if a, err := ma.NewMultiaddr(s); err == nil {
+			excluded[string(a.Bytes())] = struct{}{}
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid no announce address %s, neither a multiaddr nor a CIDR range", s)
+		}
+		ranges = append(ranges, ipnet)
+	}
+
+	return func(addrs []ma.Multiaddr) []ma.Multiaddr {
+		if len(announced) > 0 {
+			addrs = announced
+		}
+		out := make([]ma.Multiaddr, 0, len(addrs))
+		for _, a := range addrs {
+			if _, ok := excluded[string(a.Bytes())]; ok {
+				continue
+			}
+			if noPrivate && (manet.IsPrivateAddr(a) || manet.IsIPLoopback(a) || manet.IsIP6LinkLocal(a)) {
+				continue
+			}
+			if ip, err := manet.ToIP(a); err == nil && inRanges(ranges, ip) {
+				continue
+			}
+			out = append(out, a)
+		}
+		return out
+	}, nil
+}
+
+func inRanges(ranges []*net.IPNet, ip net.IP) bool {
+	for _, r := range ranges {
+		if r.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package net
+
+import (
+	"testing"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+func TestAddrsFactory(t *testing.T) {
+	tf.UnitTest(t)
+
+	listen := []string{
+		"/ip4/127.0.0.1/tcp/34567",
+		"/ip4/192.168.1.10/tcp/34567",
+		"/ip4/10.0.0.5/tcp/34567",
+		"/ip4/1.2.3.4/tcp/34567",
+		"/ip6/::1/tcp/34567",
+		"/ip6/fe80::1/tcp/34567",
+	}
+
+	for name, tc := range map[string]struct {
+		announce   []string
+		noAnnounce []string
+		noPrivate  bool
+		expect     []string
+		err        string
+	}{
+		"listen addresses unchanged": {
+			expect: listen,
+		},
+		"announce replaces listen addresses": {
+			announce: []string{"/ip4/5.6.7.8/tcp/1234", "/dns4/node.example.com/tcp/1234"},
+			expect:   []string{"/ip4/5.6.7.8/tcp/1234", "/dns4/node.example.com/tcp/1234"},
+		},
+		"no announce multiaddr": {
+			noAnnounce: []string{"/ip4/1.2.3.4/tcp/34567"},
+			expect:     []string{"/ip4/127.0.0.1/tcp/34567", "/ip4/192.168.1.10/tcp/34567", "/ip4/10.0.0.5/tcp/34567", "/ip6/::1/tcp/34567", "/ip6/fe80::1/tcp/34567"},
+		},
+		"no announce range": {
+			noAnnounce: []string{"10.0.0.0/8", "::1/128"},
+			expect:     []string{"/ip4/127.0.0.1/tcp/34567", "/ip4/192.168.1.10/tcp/34567", "/ip4/1.2.3.4/tcp/34567", "/ip6/fe80::1/tcp/34567"},
+		},
+		"no private": {
+			noPrivate: true,
+			expect:    []string{"/ip4/1.2.3.4/tcp/34567"},
+		},
+		"announce overlapping no announce": {
+			announce:   []string{"/ip4/5.6.7.8/tcp/1234", "/ip4/9.9.9.9/tcp/1234", "/ip4/172.16.0.1/tcp/1234"},
+			noAnnounce: []string{"/ip4/5.6.7.8/tcp/1234", "9.9.0.0/16"},
+			expect:     []string{"/ip4/172.16.0.1/tcp/1234"},
+		},
+		"announce with no private": {
+			announce:  []string{"/ip4/5.6.7.8/tcp/1234", "/ip4/172.16.0.1/tcp/1234"},
+			noPrivate: true,
+			expect:    []string{"/ip4/5.6.7.8/tcp/1234"},
+		},
+		"announce fully excluded": {
+			announce:   []string{"/ip4/5.6.7.8/tcp/1234"},
+			noAnnounce: []string{"5.6.7.0/24"},
+			expect:     []string{},
+		},
+		"invalid announce": {
+			announce: []string{"1.2.3.4:1234"},
+			err:      "invalid announce address",
+		},
+		"invalid no announce": {
+			noAnnounce: []string{"1.2.3.4:1234"},
+			err:        "invalid no announce address",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			factory, err := AddrsFactory(tc.announce, tc.noAnnounce, tc.noPrivate)
+			if len(tc.err) > 0 {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			got := factory(multiaddrs(t, listen))
+			require.Equal(t, multiaddrs(t, tc.expect), got)
+		})
+	}
+}
+
+func multiaddrs(t *testing.T, addrs []string) []ma.Multiaddr {
+	out := make([]ma.Multiaddr, 0, len(addrs))
+	for _, s := range addrs {
+		a, err := ma.NewMultiaddr(s)
+		require.NoError(t, err)
+		out = append(out, a)
+	}
+	return out
+}
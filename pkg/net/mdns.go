@@ -0,0 +1,46 @@
+package net
+
+import (
+	"context"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
+)
+
+var mdnsLog = logging.Logger("net/mdns")
+
+// mdnsConnectTimeout bounds the time spent dialing a peer found on the local network.
+const mdnsConnectTimeout = 10 * time.Second
+
+// NewMDNSService announces the host on the local network and connects to the peers found there,
+// so devnet nodes on the same LAN find each other without a bootstrap list. Peers on another
+// network are dropped by the hello protocol after connecting.
+func NewMDNSService(ctx context.Context, h host.Host) (mdns.Service, error) {
+	svc := mdns.NewMdnsService(h, mdns.ServiceName, &mdnsNotifee{ctx: ctx, h: h})
+	if err := svc.Start(); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+type mdnsNotifee struct {
+	ctx context.Context
+	h   host.Host
+}
+
+func (n *mdnsNotifee) HandlePeerFound(pi peer.AddrInfo) {
+	if pi.ID == n.h.ID() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(n.ctx, mdnsConnectTimeout)
+	defer cancel()
+	if err := n.h.Connect(ctx, pi); err != nil {
+		mdnsLog.Debugf("failed to connect to peer %s found over mdns: %s", pi.ID, err)
+		return
+	}
+	mdnsLog.Infof("connected to peer %s found over mdns", pi.ID)
+}
@@ -0,0 +1,105 @@
+package net
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+var relayLog = logging.Logger("net/relay")
+
+// RelayProbeInterval is the time between two probes of the static relays.
+var RelayProbeInterval = 5 * time.Minute
+
+const relayProbeTimeout = 30 * time.Second
+
+// RelayProber checks periodically that the static relays can be dialed and whether the node
+// advertises an address through each of them, the address peers behind no relay dial it on.
+type RelayProber struct {
+	h      host.Host
+	relays []peer.AddrInfo
+
+	lk     sync.Mutex
+	status map[peer.ID]types.RelayStatus
+}
+
+func NewRelayProber(h host.Host, relays []peer.AddrInfo) *RelayProber {
+	return &RelayProber{
+		h:      h,
+		relays: relays,
+		status: make(map[peer.ID]types.RelayStatus, len(relays)),
+	}
+}
+
+// Run probes the relays until ctx is done.
+func (rp *RelayProber) Run(ctx context.Context) {
+	ticker := time.NewTicker(RelayProbeInterval)
+	defer ticker.Stop()
+	for {
+		for _, relay := range rp.relays {
+			status := rp.probe(ctx, relay)
+			rp.lk.Lock()
+			rp.status[relay.ID] = status
+			rp.lk.Unlock()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (rp *RelayProber) probe(ctx context.Context, relay peer.AddrInfo) types.RelayStatus {
+	status := types.RelayStatus{ID: relay.ID, LastProbe: time.Now()}
+	ctx, cancel := context.WithTimeout(ctx, relayProbeTimeout)
+	defer cancel()
+
+	if err := rp.h.Connect(ctx, relay); err != nil {
+		relayLog.Warnf("relay %s can't be reached: %s", relay.ID, err)
+		status.Error = err.Error()
+		return status
+	}
+	res := <-ping.Ping(ctx, rp.h, relay.ID)
+	if res.Error != nil {
+		relayLog.Warnf("relay %s doesn't answer pings: %s", relay.ID, res.Error)
+		status.Error = res.Error.Error()
+		return status
+	}
+	status.Reachable = true
+	status.RTT = res.RTT
+
+	circuit := "/p2p/" + relay.ID.String() + "/p2p-circuit"
+	for _, addr := range rp.h.Addrs() {
+		if strings.Contains(addr.String(), circuit) {
+			status.Advertised = true
+			break
+		}
+	}
+	return status
+}
+
+// Status returns the result of the last probe of each relay.
+func (rp *RelayProber) Status() []types.RelayStatus {
+	rp.lk.Lock()
+	defer rp.lk.Unlock()
+
+	out := make([]types.RelayStatus, 0, len(rp.relays))
+	for _, relay := range rp.relays {
+		status, ok := rp.status[relay.ID]
+		if !ok {
+			status = types.RelayStatus{ID: relay.ID}
+		}
+		out = append(out, status)
+	}
+	return out
+}
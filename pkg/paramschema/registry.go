@@ -0,0 +1,101 @@
+// Package paramschema decodes the params of messages sent to actors the node has no bindings
+// for, such as user deployed actors, with IPLD schemas supplied by the operator.
+package paramschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	"github.com/ipld/go-ipld-prime/node/bindnode"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// Manifest lists the actors whose params can be decoded.
+type Manifest struct {
+	Actors []ActorSchema `json:"actors"`
+}
+
+// ActorSchema maps the methods of the actor with the code cid to the types of their params.
+type ActorSchema struct {
+	Code string `json:"code"`
+	// Schema is the IPLD schema file defining the types, relative to the manifest
+	Schema string `json:"schema"`
+	// Methods maps method numbers to the name of the type of their params
+	Methods map[abi.MethodNum]string `json:"methods"`
+}
+
+// Registry holds the param types of the methods of the actors listed in a manifest. A nil
+// Registry decodes nothing.
+type Registry struct {
+	methods map[cid.Cid]map[abi.MethodNum]schema.TypedPrototype
+}
+
+// Load reads the manifest at path and the schema files it refers to.
+func Load(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing param schema manifest %s: %w", path, err)
+	}
+
+	r := &Registry{methods: make(map[cid.Cid]map[abi.MethodNum]schema.TypedPrototype, len(manifest.Actors))}
+	for _, actor := range manifest.Actors {
+		code, err := cid.Decode(actor.Code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid actor code %s: %w", actor.Code, err)
+		}
+		schemaPath := actor.Schema
+		if !filepath.IsAbs(schemaPath) {
+			schemaPath = filepath.Join(filepath.Dir(path), schemaPath)
+		}
+		ts, err := ipld.LoadSchemaFile(schemaPath)
+		if err != nil {
+			return nil, fmt.Errorf("loading schema of actor %s: %w", code, err)
+		}
+
+		methods := make(map[abi.MethodNum]schema.TypedPrototype, len(actor.Methods))
+		for method, name := range actor.Methods {
+			typ := ts.TypeByName(name)
+			if typ == nil {
+				return nil, fmt.Errorf("type %s of method %d of actor %s not found in %s", name, method, code, actor.Schema)
+			}
+			methods[method] = bindnode.Prototype(nil, typ)
+		}
+		r.methods[code] = methods
+	}
+	return r, nil
+}
+
+// Decode decodes the params of the method of the actor with the code into JSON. The bool is false
+// when no schema covers the method.
+func (r *Registry) Decode(code cid.Cid, method abi.MethodNum, params []byte) (json.RawMessage, bool, error) {
+	if r == nil {
+		return nil, false, nil
+	}
+	proto, ok := r.methods[code][method]
+	if !ok {
+		return nil, false, nil
+	}
+
+	n, err := ipld.DecodeUsingPrototype(params, dagcbor.Decode, proto.Representation())
+	if err != nil {
+		return nil, true, fmt.Errorf("decoding params as %s: %w", proto.Type().Name(), err)
+	}
+	// encode the type level node rather than its representation, so fields are named
+	var buf bytes.Buffer
+	if err := dagjson.Encode(bindnode.Wrap(bindnode.Unwrap(n), proto.Type()), &buf); err != nil {
+		return nil, true, fmt.Errorf("encoding params as json: %w", err)
+	}
+	return buf.Bytes(), true, nil
+}
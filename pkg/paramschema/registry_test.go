@@ -0,0 +1,56 @@
+package paramschema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+)
+
+const testSchema = `
+type TransferParams struct {
+	amount Int
+	memo String
+} representation tuple
+`
+
+func TestRegistryDecode(t *testing.T) {
+	tf.UnitTest(t)
+
+	code, err := cid.Decode("bafk2bzaceaqkdvgfsjxtvrlrgrl7zmykhbsolk5f2eayyhvhdzx4ujd2pmm7c")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token.ipldsch"), []byte(testSchema), 0o644))
+	manifest := `{"actors": [{"code": "` + code.String() + `", "schema": "token.ipldsch", "methods": {"2": "TransferParams"}}]}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(manifest), 0o644))
+
+	r, err := Load(filepath.Join(dir, "manifest.json"))
+	require.NoError(t, err)
+
+	// [5, "hello"]
+	params := []byte{0x82, 0x05, 0x65, 'h', 'e', 'l', 'l', 'o'}
+	out, ok, err := r.Decode(code, 2, params)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.JSONEq(t, `{"amount": 5, "memo": "hello"}`, string(out))
+
+	_, ok, err = r.Decode(code, 3, params)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = r.Decode(code, 2, []byte{0x01})
+	assert.Error(t, err)
+	assert.True(t, ok)
+
+	var empty *Registry
+	_, ok, err = empty.Decode(code, abi.MethodNum(2), params)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
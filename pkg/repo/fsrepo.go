@@ -385,6 +385,9 @@ func (r *FSRepo) openDatastore() error {
 			return err
 		}
 		opts.Prefix = bstore.BlockPrefix.String()
+		if err := opts.SetCompression(Config.Datastore.Compression); err != nil {
+			return err
+		}
 		ds, err := blockstoreutil.Open(opts)
 		if err != nil {
 			return err
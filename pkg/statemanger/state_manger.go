@@ -35,6 +35,9 @@ import (
 
 var execTraceCacheSize = 16
 
+// addrCacheSize is the number of address resolutions kept by the state manager.
+var addrCacheSize = 8192
+
 // stateManagerAPI defines the methods needed from StateManager
 // todo remove this code and add private interface in market and paychanel package
 type IStateManager interface {
@@ -53,6 +56,14 @@ type tipSetCacheEntry struct {
 	invocTrace    []*types.InvocResult
 }
 
+// addrCacheKey identifies the resolution of an address in the parent state of a tipset, the
+// state of a tipset never changes so resolutions don't need to be invalidated.
+type addrCacheKey struct {
+	parents types.TipSetKey
+	addr    address.Address
+	toID    bool
+}
+
 var _ IStateManager = &Stmgr{}
 var log = logging.Logger("statemanager")
 
@@ -82,6 +93,9 @@ type Stmgr struct {
 	// We need a lock while making the copy as to prevent other callers
 	// overwrite the cache while making the copy
 	execTraceCacheLock sync.Mutex
+
+	// resolutions between ID and key addresses, which nearly every client asks for
+	addrCache *arc.ARCCache[addrCacheKey, address.Address]
 }
 
 func NewStateManager(cs *chain.Store,
@@ -102,6 +116,10 @@ func NewStateManager(cs *chain.Store,
 			return nil, err
 		}
 	}
+	addrCache, err := arc.NewARC[addrCacheKey, address.Address](addrCacheSize)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Stmgr{
 		cs:             cs,
@@ -115,6 +133,7 @@ func NewStateManager(cs *chain.Store,
 		chsWorkingOn:   make(map[types.TipSetKey]chan struct{}, 1),
 		actorDebugging: actorDebugging,
 		execTraceCache: execTraceCache,
+		addrCache:      addrCache,
 	}, nil
 }
 
@@ -138,11 +157,49 @@ func (s *Stmgr) ResolveToDeterministicAddress(ctx context.Context, addr address.
 	default:
 	}
 
+	if ts == nil {
+		ts = s.cs.GetHead()
+	}
+	key := addrCacheKey{parents: ts.Parents(), addr: addr}
+	if keyAddr, ok := s.addrCache.Get(key); ok {
+		return keyAddr, nil
+	}
+
 	_, view, err := s.ParentStateView(ctx, ts)
 	if err != nil {
 		return address.Undef, err
 	}
-	return view.ResolveToDeterministicAddress(ctx, addr)
+	keyAddr, err := view.ResolveToDeterministicAddress(ctx, addr)
+	if err != nil {
+		return address.Undef, err
+	}
+	s.addrCache.Add(key, keyAddr)
+	return keyAddr, nil
+}
+
+// LookupID resolves addr to its ID address in the parent state of ts.
+func (s *Stmgr) LookupID(ctx context.Context, addr address.Address, ts *types.TipSet) (address.Address, error) {
+	if addr.Protocol() == address.ID {
+		return addr, nil
+	}
+	if ts == nil {
+		ts = s.cs.GetHead()
+	}
+	key := addrCacheKey{parents: ts.Parents(), addr: addr, toID: true}
+	if idAddr, ok := s.addrCache.Get(key); ok {
+		return idAddr, nil
+	}
+
+	_, state, err := s.ParentState(ctx, ts)
+	if err != nil {
+		return address.Undef, fmt.Errorf("load state failed: %v", err)
+	}
+	idAddr, err := state.LookupID(addr)
+	if err != nil {
+		return address.Undef, err
+	}
+	s.addrCache.Add(key, idAddr)
+	return idAddr, nil
 }
 
 func (s *Stmgr) GetPaychState(ctx context.Context, addr address.Address, ts *types.TipSet) (*types.Actor, paych.State, error) {
@@ -344,6 +401,12 @@ func (s *Stmgr) GetActorAt(ctx context.Context, addr address.Address, ts *types.
 	return actor, nil
 }
 
+// ExecuteTipSet executes ts again, ignoring and leaving untouched the results stored for it, to
+// check them.
+func (s *Stmgr) ExecuteTipSet(ctx context.Context, ts *types.TipSet) (cid.Cid, cid.Cid, error) {
+	return s.cp.RunStateTransition(ctx, ts, nil, false)
+}
+
 // deprecated: in future use.
 func (s *Stmgr) RunStateTransitionV2(ctx context.Context, ts *types.TipSet) (cid.Cid, cid.Cid, error) {
 	ctx, span := trace.StartSpan(ctx, "Exected.RunStateTransition")
@@ -0,0 +1,103 @@
+package statemanger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car"
+	carutil "github.com/ipld/go-car/util"
+
+	blockstoreutil "github.com/filecoin-project/venus/venus-shared/blockstore"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// witnessBlockstore records the blocks read from the underlying store, in the order they are
+// first read.
+type witnessBlockstore struct {
+	blockstoreutil.Blockstore
+
+	lk    sync.Mutex
+	seen  map[cid.Cid]struct{}
+	reads []blocks.Block
+}
+
+func newWitnessBlockstore(bs blockstoreutil.Blockstore) *witnessBlockstore {
+	return &witnessBlockstore{Blockstore: bs, seen: make(map[cid.Cid]struct{})}
+}
+
+func (wb *witnessBlockstore) record(c cid.Cid, data []byte) {
+	wb.lk.Lock()
+	defer wb.lk.Unlock()
+	if _, ok := wb.seen[c]; ok {
+		return
+	}
+	wb.seen[c] = struct{}{}
+	blk, err := blocks.NewBlockWithCid(append([]byte(nil), data...), c)
+	if err != nil {
+		return
+	}
+	wb.reads = append(wb.reads, blk)
+}
+
+func (wb *witnessBlockstore) Get(ctx context.Context, c cid.Cid) (blocks.Block, error) {
+	blk, err := wb.Blockstore.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	wb.record(c, blk.RawData())
+	return blk, nil
+}
+
+func (wb *witnessBlockstore) View(ctx context.Context, c cid.Cid, callback func([]byte) error) error {
+	return wb.Blockstore.View(ctx, c, func(data []byte) error {
+		wb.record(c, data)
+		return callback(data)
+	})
+}
+
+// writeCar writes the recorded blocks as a CAR rooted at root.
+func (wb *witnessBlockstore) writeCar(root cid.Cid) ([]byte, error) {
+	wb.lk.Lock()
+	defer wb.lk.Unlock()
+
+	var buf bytes.Buffer
+	if err := car.WriteHeader(&car.CarHeader{Roots: []cid.Cid{root}, Version: 1}, &buf); err != nil {
+		return nil, err
+	}
+	for _, blk := range wb.reads {
+		if err := carutil.LdWrite(&buf, blk.Cid().Bytes(), blk.RawData()); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// CallWithWitness runs msg like Call and also returns the state tree nodes read by the execution,
+// as a CAR rooted at the parent state of ts. Replaying msg on the parent state of ts over those
+// blocks alone only gives the same result when the call is that plain replay, that is:
+//   - the sender has no messages in ts, as Call applies them before msg and they are not part of
+//     the witness;
+//   - no state migration runs at the height of ts, as its reads are not recorded;
+//   - the execution doesn't depend on state read through the chain store rather than the call
+//     blockstore, such as the lookback state and the chain randomness.
+func (s *Stmgr) CallWithWitness(ctx context.Context, msg *types.Message, ts *types.TipSet) (*types.CallWitness, error) {
+	wb := newWitnessBlockstore(s.cs.Blockstore())
+	res, err := s.call(ctx, msg, ts, wb)
+	if err != nil {
+		return nil, err
+	}
+
+	witness, err := wb.writeCar(ts.ParentState())
+	if err != nil {
+		return nil, fmt.Errorf("writing witness: %w", err)
+	}
+	return &types.CallWitness{
+		Result:    res,
+		StateRoot: ts.ParentState(),
+		Witness:   witness,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package statemanger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	cbor "github.com/ipfs/go-ipld-cbor"
+	"github.com/ipld/go-car"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/venus/pkg/state/tree"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	blockstoreutil "github.com/filecoin-project/venus/venus-shared/blockstore"
+	"github.com/filecoin-project/venus/venus-shared/testutil"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func TestWitnessReplay(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	bs := blockstoreutil.NewMemory()
+	st, err := tree.NewState(cbor.NewCborStore(bs), tree.StateTreeVersion5)
+	require.NoError(t, err)
+
+	code := testutil.CidProvider(32)(t)
+	var addrs []address.Address
+	for i := 100; i < 300; i++ {
+		addr, err := address.NewIDAddress(uint64(i))
+		require.NoError(t, err)
+		require.NoError(t, st.SetActor(ctx, addr, &types.Actor{
+			Code:    code,
+			Head:    code,
+			Balance: abi.NewTokenAmount(int64(i)),
+			Nonce:   uint64(i),
+		}))
+		addrs = append(addrs, addr)
+	}
+	root, err := st.Flush(ctx)
+	require.NoError(t, err)
+
+	// the reads of a call, run over the full state
+	call := func(bs blockstoreutil.Blockstore) ([]*types.Actor, error) {
+		st, err := tree.LoadState(ctx, cbor.NewCborStore(bs), root)
+		if err != nil {
+			return nil, err
+		}
+		var out []*types.Actor
+		for _, addr := range []address.Address{addrs[0], addrs[150]} {
+			act, found, err := st.GetActor(ctx, addr)
+			if err != nil {
+				return nil, err
+			}
+			require.True(t, found)
+			out = append(out, act)
+		}
+		return out, nil
+	}
+
+	wb := newWitnessBlockstore(bs)
+	expect, err := call(wb)
+	require.NoError(t, err)
+	witness, err := wb.writeCar(root)
+	require.NoError(t, err)
+
+	// replaying the call over the witness alone reads the same state
+	replay := blockstoreutil.NewMemory()
+	header, err := car.LoadCar(ctx, replay, bytes.NewReader(witness))
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{root}, header.Roots)
+	got, err := call(replay)
+	require.NoError(t, err)
+	require.Equal(t, expect, got)
+
+	// the witness holds what the call read, not the whole state
+	all, err := replay.AllKeysChan(ctx)
+	require.NoError(t, err)
+	var witnessed int
+	for range all {
+		witnessed++
+	}
+	require.Equal(t, len(wb.reads), witnessed)
+	full, err := bs.AllKeysChan(ctx)
+	require.NoError(t, err)
+	var total int
+	for range full {
+		total++
+	}
+	require.Less(t, witnessed, total)
+}
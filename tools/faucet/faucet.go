@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/builtin"
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// faucetAPI is the part of the node api the faucet needs.
+type faucetAPI interface {
+	MpoolPushMessage(ctx context.Context, msg *types.Message, spec *types.MessageSendSpec) (*types.SignedMessage, error)
+	StateAccountKey(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error)
+	StateLookupID(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error)
+}
+
+// requestTimeout bounds the calls to the captcha endpoint and the node made for one request.
+const requestTimeout = 30 * time.Second
+
+// CaptchaVerifier checks the captcha response a client submitted with its request.
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, response string, remoteIP string) (bool, error)
+}
+
+type noCaptcha struct{}
+
+func (noCaptcha) Verify(context.Context, string, string) (bool, error) {
+	return true, nil
+}
+
+// httpCaptcha verifies captcha responses against a recaptcha/hcaptcha compatible endpoint.
+type httpCaptcha struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func (c *httpCaptcha) Verify(ctx context.Context, response string, remoteIP string) (bool, error) {
+	if len(response) == 0 {
+		return false, nil
+	}
+	form := url.Values{
+		"secret":   {c.secret},
+		"response": {response},
+		"remoteip": {remoteIP},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("verifying captcha: %w", err)
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	var res struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return false, fmt.Errorf("decoding captcha verification: %w", err)
+	}
+	return res.Success, nil
+}
+
+// rateLimiter allows one event per key within interval.
+type rateLimiter struct {
+	interval time.Duration
+
+	lk        sync.Mutex
+	last      map[string]time.Time
+	lastPrune time.Time
+}
+
+func newRateLimiter(interval time.Duration) *rateLimiter {
+	return &rateLimiter{interval: interval, last: make(map[string]time.Time)}
+}
+
+// reserve records an event for key at now, it returns the time to wait instead when the
+// previous event for key is too recent.
+func (rl *rateLimiter) reserve(key string, now time.Time) (time.Duration, bool) {
+	rl.lk.Lock()
+	defer rl.lk.Unlock()
+
+	if now.Sub(rl.lastPrune) >= rl.interval {
+		rl.prune(now)
+	}
+	if last, ok := rl.last[key]; ok && now.Sub(last) < rl.interval {
+		return rl.interval - now.Sub(last), false
+	}
+	rl.last[key] = now
+	return 0, true
+}
+
+// prune forgets the events older than interval, they no longer limit anything. It runs at most
+// once per interval so the map stays bounded by the number of keys seen in two intervals.
+func (rl *rateLimiter) prune(now time.Time) {
+	for key, last := range rl.last {
+		if now.Sub(last) >= rl.interval {
+			delete(rl.last, key)
+		}
+	}
+	rl.lastPrune = now
+}
+
+// cancel forgets the event recorded for key at t, so a failed dispense doesn't count.
+func (rl *rateLimiter) cancel(key string, t time.Time) {
+	rl.lk.Lock()
+	defer rl.lk.Unlock()
+
+	if rl.last[key] == t {
+		delete(rl.last, key)
+	}
+}
+
+// Faucet is an http handler that dispenses a fixed amount of FIL from a wallet address,
+// rate limited per receiving address and per client ip.
+type Faucet struct {
+	api     faucetAPI
+	from    address.Address
+	amount  abi.TokenAmount
+	captcha CaptchaVerifier
+
+	addrLimiter *rateLimiter
+	ipLimiter   *rateLimiter
+	now         func() time.Time
+}
+
+func NewFaucet(api faucetAPI, from address.Address, amount abi.TokenAmount, addrInterval, ipInterval time.Duration, captcha CaptchaVerifier) *Faucet {
+	return &Faucet{
+		api:         api,
+		from:        from,
+		amount:      amount,
+		captcha:     captcha,
+		addrLimiter: newRateLimiter(addrInterval),
+		ipLimiter:   newRateLimiter(ipInterval),
+		now:         time.Now,
+	}
+}
+
+type sendResult struct {
+	Message cid.Cid `json:",omitempty"`
+	Error   string  `json:",omitempty"`
+}
+
+func (f *Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/send" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		f.respond(w, http.StatusMethodNotAllowed, sendResult{Error: "only POST is supported"})
+		return
+	}
+
+	to, err := address.NewFromString(r.FormValue("address"))
+	if err != nil {
+		f.respond(w, http.StatusBadRequest, sendResult{Error: fmt.Sprintf("invalid address: %v", err)})
+		return
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	ok, err := f.captcha.Verify(ctx, r.FormValue("captcha"), ip)
+	if err != nil {
+		log.Printf("captcha verification failed: %v", err)
+		f.respond(w, http.StatusInternalServerError, sendResult{Error: "captcha verification failed"})
+		return
+	}
+	if !ok {
+		f.respond(w, http.StatusForbidden, sendResult{Error: "invalid captcha"})
+		return
+	}
+
+	limited, err := f.limitedAddress(ctx, to)
+	if err != nil {
+		f.respond(w, http.StatusBadRequest, sendResult{Error: fmt.Sprintf("resolving %s: %v", to, err)})
+		return
+	}
+	key := limited.String()
+
+	now := f.now()
+	if wait, ok := f.ipLimiter.reserve(ip, now); !ok {
+		f.respond(w, http.StatusTooManyRequests, sendResult{Error: fmt.Sprintf("too many requests from %s, retry in %s", ip, wait.Round(time.Second))})
+		return
+	}
+	if wait, ok := f.addrLimiter.reserve(key, now); !ok {
+		f.ipLimiter.cancel(ip, now)
+		f.respond(w, http.StatusTooManyRequests, sendResult{Error: fmt.Sprintf("%s was funded recently, retry in %s", to, wait.Round(time.Second))})
+		return
+	}
+
+	smsg, err := f.api.MpoolPushMessage(ctx, &types.Message{
+		From:   f.from,
+		To:     to,
+		Value:  f.amount,
+		Method: builtin.MethodSend,
+	}, nil)
+	if err != nil {
+		f.ipLimiter.cancel(ip, now)
+		f.addrLimiter.cancel(key, now)
+		log.Printf("sending %s to %s failed: %v", types.FIL(f.amount), to, err)
+		f.respond(w, http.StatusInternalServerError, sendResult{Error: "failed to send funds"})
+		return
+	}
+
+	log.Printf("sent %s to %s for %s in %s", types.FIL(f.amount), to, ip, smsg.Cid())
+	f.respond(w, http.StatusOK, sendResult{Message: smsg.Cid()})
+}
+
+// limitedAddress is the address the dispenses to `to` are rate limited by, so a wallet given by
+// its id address and by its key address shares one allowance. Key addresses are used as they are,
+// id and actor addresses are resolved to their key address, or to their id address for actors
+// which don't have one.
+func (f *Faucet) limitedAddress(ctx context.Context, to address.Address) (address.Address, error) {
+	if to.Protocol() != address.ID && to.Protocol() != address.Actor {
+		return to, nil
+	}
+	if key, err := f.api.StateAccountKey(ctx, to, types.EmptyTSK); err == nil {
+		return key, nil
+	}
+	return f.api.StateLookupID(ctx, to, types.EmptyTSK)
+}
+
+func (f *Faucet) respond(w http.ResponseWriter, code int, res sendResult) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.Printf("writing response: %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/testutil"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+type mockNode struct {
+	pushed []*types.Message
+	err    error
+	// accounts maps the id addresses of the accounts to their key addresses
+	accounts map[address.Address]address.Address
+}
+
+func (m *mockNode) StateAccountKey(_ context.Context, addr address.Address, _ types.TipSetKey) (address.Address, error) {
+	if key, ok := m.accounts[addr]; ok {
+		return key, nil
+	}
+	return address.Undef, fmt.Errorf("actor %s not found", addr)
+}
+
+func (m *mockNode) StateLookupID(_ context.Context, addr address.Address, _ types.TipSetKey) (address.Address, error) {
+	for id, key := range m.accounts {
+		if key == addr {
+			return id, nil
+		}
+	}
+	return address.Undef, fmt.Errorf("actor %s not found", addr)
+}
+
+func (m *mockNode) MpoolPushMessage(_ context.Context, msg *types.Message, _ *types.MessageSendSpec) (*types.SignedMessage, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.pushed = append(m.pushed, msg)
+	return &types.SignedMessage{Message: *msg}, nil
+}
+
+type fixedCaptcha bool
+
+func (c fixedCaptcha) Verify(context.Context, string, string) (bool, error) {
+	return bool(c), nil
+}
+
+// keyAddress is a random key address, which the faucet limits without asking the node.
+func keyAddress(t *testing.T) address.Address {
+	pubKey := make([]byte, 65)
+	_, err := rand.Read(pubKey)
+	require.NoError(t, err)
+	addr, err := address.NewSecp256k1Address(pubKey)
+	require.NoError(t, err)
+	return addr
+}
+
+func send(t *testing.T, f *Faucet, ip string, form url.Values) (int, sendResult) {
+	req := httptest.NewRequest(http.MethodPost, "/send", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.RemoteAddr = ip + ":1234"
+	rec := httptest.NewRecorder()
+	f.ServeHTTP(rec, req)
+
+	var res sendResult
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&res))
+	return rec.Code, res
+}
+
+func TestFaucetRateLimit(t *testing.T) {
+	tf.UnitTest(t)
+
+	node := &mockNode{}
+	from := testutil.AddressProvider()(t)
+	f := NewFaucet(node, from, abi.NewTokenAmount(100), time.Hour, time.Minute, noCaptcha{})
+	now := time.Now()
+	f.now = func() time.Time { return now }
+
+	addr1 := keyAddress(t)
+	addr2 := keyAddress(t)
+
+	code, res := send(t, f, "1.1.1.1", url.Values{"address": {addr1.String()}})
+	require.Equal(t, http.StatusOK, code, res.Error)
+	require.Len(t, node.pushed, 1)
+	require.Equal(t, addr1, node.pushed[0].To)
+	require.Equal(t, from, node.pushed[0].From)
+	require.Equal(t, abi.NewTokenAmount(100), node.pushed[0].Value)
+
+	// same ip, different address
+	code, _ = send(t, f, "1.1.1.1", url.Values{"address": {addr2.String()}})
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	// same address, different ip
+	code, _ = send(t, f, "2.2.2.2", url.Values{"address": {addr1.String()}})
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	// the rejected address request must not use up the quota of 2.2.2.2
+	code, _ = send(t, f, "2.2.2.2", url.Values{"address": {addr2.String()}})
+	require.Equal(t, http.StatusOK, code)
+
+	now = now.Add(time.Minute)
+	code, _ = send(t, f, "1.1.1.1", url.Values{"address": {addr1.String()}})
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	now = now.Add(time.Hour)
+	code, _ = send(t, f, "1.1.1.1", url.Values{"address": {addr1.String()}})
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, node.pushed, 3)
+}
+
+func TestFaucetRateLimitResolvesAddress(t *testing.T) {
+	tf.UnitTest(t)
+
+	key := keyAddress(t)
+	id, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	unknown, err := address.NewIDAddress(1001)
+	require.NoError(t, err)
+	node := &mockNode{accounts: map[address.Address]address.Address{id: key}}
+	f := NewFaucet(node, testutil.AddressProvider()(t), abi.NewTokenAmount(100), time.Hour, time.Nanosecond, noCaptcha{})
+
+	code, res := send(t, f, "1.1.1.1", url.Values{"address": {id.String()}})
+	require.Equal(t, http.StatusOK, code, res.Error)
+	require.Equal(t, id, node.pushed[0].To)
+
+	// the same wallet by its key address shares the allowance
+	code, _ = send(t, f, "2.2.2.2", url.Values{"address": {key.String()}})
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	code, _ = send(t, f, "3.3.3.3", url.Values{"address": {unknown.String()}})
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Len(t, node.pushed, 1)
+}
+
+func TestRateLimiterPrune(t *testing.T) {
+	tf.UnitTest(t)
+
+	rl := newRateLimiter(time.Minute)
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		_, ok := rl.reserve(fmt.Sprintf("key-%d", i), now)
+		require.True(t, ok)
+	}
+	require.Len(t, rl.last, 10)
+
+	now = now.Add(30 * time.Second)
+	_, ok := rl.reserve("key-0", now)
+	require.False(t, ok)
+	require.Len(t, rl.last, 10)
+
+	now = now.Add(time.Minute)
+	_, ok = rl.reserve("other", now)
+	require.True(t, ok)
+	require.Len(t, rl.last, 1, "entries older than the interval must be pruned")
+}
+
+func TestFaucetRejects(t *testing.T) {
+	tf.UnitTest(t)
+
+	node := &mockNode{}
+	addr := keyAddress(t)
+
+	f := NewFaucet(node, testutil.AddressProvider()(t), abi.NewTokenAmount(100), time.Hour, time.Hour, fixedCaptcha(false))
+	code, _ := send(t, f, "1.1.1.1", url.Values{"address": {addr.String()}})
+	require.Equal(t, http.StatusForbidden, code)
+
+	f = NewFaucet(node, testutil.AddressProvider()(t), abi.NewTokenAmount(100), time.Hour, time.Hour, noCaptcha{})
+	code, _ = send(t, f, "1.1.1.1", url.Values{"address": {"not an address"}})
+	require.Equal(t, http.StatusBadRequest, code)
+
+	// a failed push doesn't count against the limits
+	node.err = fmt.Errorf("mpool is full")
+	code, _ = send(t, f, "1.1.1.1", url.Values{"address": {addr.String()}})
+	require.Equal(t, http.StatusInternalServerError, code)
+	node.err = nil
+	code, _ = send(t, f, "1.1.1.1", url.Values{"address": {addr.String()}})
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, node.pushed, 1)
+}
+
+func TestHTTPCaptcha(t *testing.T) {
+	tf.UnitTest(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		ok := r.PostForm.Get("secret") == "secret" && r.PostForm.Get("response") == "good"
+		_, _ = fmt.Fprintf(w, `{"success": %v}`, ok)
+	}))
+	defer srv.Close()
+
+	c := &httpCaptcha{url: srv.URL, secret: "secret", client: srv.Client()}
+	ok, err := c.Verify(context.Background(), "good", "1.1.1.1")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = c.Verify(context.Background(), "bad", "1.1.1.1")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
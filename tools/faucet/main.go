@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+
+	v1 "github.com/filecoin-project/venus/venus-shared/api/chain/v1"
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:7777", "address the faucet http service listens on")
+	apiAddr := flag.String("api", "/ip4/127.0.0.1/tcp/3453", "multiaddr or url of the venus node api")
+	token := flag.String("token", "", "venus node api token, requires the sign permission")
+	from := flag.String("from", "", "wallet address the test FIL is dispensed from")
+	amount := flag.String("amount", "10 FIL", "amount of FIL dispensed per request")
+	addrInterval := flag.Duration("address-interval", 24*time.Hour, "minimum interval between two dispenses to the same address")
+	ipInterval := flag.Duration("ip-interval", time.Hour, "minimum interval between two dispenses to the same client ip")
+	captchaURL := flag.String("captcha-verify-url", "", "url of a recaptcha/hcaptcha compatible verification endpoint, captcha is disabled when empty")
+	captchaSecret := flag.String("captcha-secret", "", "secret passed to the captcha verification endpoint")
+	flag.Parse()
+
+	if err := run(*listen, *apiAddr, *token, *from, *amount, *addrInterval, *ipInterval, *captchaURL, *captchaSecret); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(listen, apiAddr, token, from, amount string, addrInterval, ipInterval time.Duration, captchaURL, captchaSecret string) error {
+	ctx := context.Background()
+
+	fromAddr, err := address.NewFromString(from)
+	if err != nil {
+		return fmt.Errorf("invalid from address %q: %w", from, err)
+	}
+	value, err := types.ParseNonNegativeFIL(amount)
+	if err != nil {
+		return fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+
+	node, closer, err := v1.DialFullNodeRPC(ctx, apiAddr, token, nil)
+	if err != nil {
+		return fmt.Errorf("connecting to venus node: %w", err)
+	}
+	defer closer()
+
+	networkName, err := node.StateNetworkName(ctx)
+	if err != nil {
+		return fmt.Errorf("getting network name: %w", err)
+	}
+	if networkName == types.NetworkNameMain {
+		return fmt.Errorf("the faucet is only meant for test networks, refusing to run against mainnet")
+	}
+
+	var captcha CaptchaVerifier = noCaptcha{}
+	if len(captchaURL) > 0 {
+		captcha = &httpCaptcha{url: captchaURL, secret: captchaSecret, client: &http.Client{Timeout: requestTimeout}}
+	}
+
+	f := NewFaucet(node, fromAddr, types.BigInt(value), addrInterval, ipInterval, captcha)
+	log.Printf("dispensing %s from %s on %s, listening on %s", value, fromAddr, networkName, listen)
+	return http.ListenAndServe(listen, f)
+}
@@ -259,6 +259,9 @@ type DealState interface {
 	SectorStartEpoch() abi.ChainEpoch // -1 if not yet included in proven sector
 	LastUpdatedEpoch() abi.ChainEpoch // -1 if deal state never updated
 	SlashEpoch() abi.ChainEpoch       // -1 if deal never slashed
+	// SectorNumber is the sector holding the deal, only recorded since actors v13. It's 0 until the
+	// deal is included in a proven sector, but 0 is also a valid sector number.
+	SectorNumber() abi.SectorNumber
 
 	Equals(other DealState) bool
 }
@@ -309,6 +312,10 @@ func (e *emptyDealState) SlashEpoch() abi.ChainEpoch {
 	return -1
 }
 
+func (e *emptyDealState) SectorNumber() abi.SectorNumber {
+	return 0
+}
+
 func (e *emptyDealState) Equals(other DealState) bool {
 	if e.SectorStartEpoch() != other.SectorStartEpoch() {
 		return false
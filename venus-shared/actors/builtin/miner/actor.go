@@ -22,6 +22,7 @@ import (
 	minertypes "github.com/filecoin-project/go-state-types/builtin/v9/miner"
 	"github.com/filecoin-project/go-state-types/manifest"
 	"github.com/filecoin-project/venus/venus-shared/actors/adt"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin"
 	"github.com/filecoin-project/venus/venus-shared/actors/types"
 
 	builtin0 "github.com/filecoin-project/specs-actors/actors/builtin"
@@ -161,6 +162,10 @@ type State interface {
 	GetSector(abi.SectorNumber) (*SectorOnChainInfo, error)
 	FindSector(abi.SectorNumber) (*SectorLocation, error)
 	GetSectorExpiration(abi.SectorNumber) (*SectorExpiration, error)
+	// FaultFee returns the penalty charged for each proving period the given sectors stay faulty.
+	FaultFee(sectorNos []abi.SectorNumber, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error)
+	// TerminationFee returns the penalty charged for terminating the given sectors at epoch.
+	TerminationFee(sectorNos []abi.SectorNumber, epoch abi.ChainEpoch, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error)
 	GetPrecommittedSector(abi.SectorNumber) (*SectorPreCommitOnChainInfo, error)
 	ForEachPrecommittedSector(func(SectorPreCommitOnChainInfo) error) error
 	LoadSectors(sectorNos *bitfield.BitField) ([]*SectorOnChainInfo, error)
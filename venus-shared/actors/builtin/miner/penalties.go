@@ -0,0 +1,51 @@
+package miner
+
+import (
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin"
+)
+
+// The penalty parameters of builtin-actors, which go-state-types doesn't carry. They are the same
+// as in specs-actors v7 up to actors v13.
+var (
+	// ContinuedFaultProjectionPeriod is the number of epochs of expected reward charged for each
+	// proving period a sector stays faulty, 3.51 days.
+	ContinuedFaultProjectionPeriod = abi.ChainEpoch((builtin.EpochsInDay * 351) / 100)
+	// TerminationPenaltyLowerBoundProjectionPeriod is the number of epochs of expected reward
+	// charged at least for terminating a sector, 3.5 days.
+	TerminationPenaltyLowerBoundProjectionPeriod = abi.ChainEpoch((builtin.EpochsInDay * 35) / 10)
+	// TerminationLifetimeCap caps the sector age counted by the termination penalty.
+	TerminationLifetimeCap = abi.ChainEpoch(140) * builtin.EpochsInDay
+
+	terminationRewardFactorNum   = big.NewInt(1)
+	terminationRewardFactorDenom = big.NewInt(2)
+)
+
+// pledgePenaltyForTermination returns the penalty for terminating a sector before it expires, as
+// max(lowerBound, twentyDayRewardAtActivation + dayReward * termination reward factor * min(age, cap)),
+// where the age of the replaced sector is counted up to the cap too.
+func pledgePenaltyForTermination(dayReward abi.TokenAmount, sectorAge abi.ChainEpoch, twentyDayRewardAtActivation abi.TokenAmount,
+	lowerBound abi.TokenAmount, replacedDayReward abi.TokenAmount, replacedSectorAge abi.ChainEpoch) abi.TokenAmount {
+	if replacedDayReward.Int == nil {
+		replacedDayReward = big.Zero()
+	}
+
+	cappedSectorAge := minEpoch(sectorAge, TerminationLifetimeCap)
+	expectedReward := big.Mul(dayReward, big.NewInt(int64(cappedSectorAge)))
+	relevantReplacedAge := minEpoch(replacedSectorAge, TerminationLifetimeCap-cappedSectorAge)
+	expectedReward = big.Add(expectedReward, big.Mul(replacedDayReward, big.NewInt(int64(relevantReplacedAge))))
+
+	penalizedReward := big.Mul(expectedReward, terminationRewardFactorNum)
+	penalizedReward = big.Div(penalizedReward, big.Mul(big.NewInt(builtin.EpochsInDay), terminationRewardFactorDenom))
+
+	return big.Max(lowerBound, big.Add(twentyDayRewardAtActivation, penalizedReward))
+}
+
+func minEpoch(a, b abi.ChainEpoch) abi.ChainEpoch {
+	if a < b {
+		return a
+	}
+	return b
+}
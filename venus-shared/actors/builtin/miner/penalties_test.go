@@ -0,0 +1,146 @@
+package miner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	miner11 "github.com/filecoin-project/go-state-types/builtin/v11/miner"
+	adt11 "github.com/filecoin-project/go-state-types/builtin/v11/util/adt"
+	miner13 "github.com/filecoin-project/go-state-types/builtin/v13/miner"
+	adt13 "github.com/filecoin-project/go-state-types/builtin/v13/util/adt"
+	miner7 "github.com/filecoin-project/specs-actors/v7/actors/builtin/miner"
+	smoothing7 "github.com/filecoin-project/specs-actors/v7/actors/util/smoothing"
+	"github.com/ipfs/go-cid"
+	cbor "github.com/ipfs/go-ipld-cbor"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/filecoin-project/venus/venus-shared/actors/adt"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin"
+	"github.com/filecoin-project/venus/venus-shared/blockstore"
+)
+
+const day = abi.ChainEpoch(builtin.EpochsInDay)
+
+// rewardEstimate returns estimates for which the expected reward of any sector over any period is
+// reward, because the network power is zero.
+func rewardEstimate(reward int64) (builtin.FilterEstimate, builtin.FilterEstimate) {
+	return builtin.FilterEstimate{
+			PositionEstimate: big.Lsh(big.NewInt(reward), 128),
+			VelocityEstimate: big.Zero(),
+		}, builtin.FilterEstimate{
+			PositionEstimate: big.Zero(),
+			VelocityEstimate: big.Zero(),
+		}
+}
+
+func TestPledgePenaltyForTermination(t *testing.T) {
+	tf.UnitTest(t)
+
+	for name, tc := range map[string]struct {
+		dayReward, twentyDayReward, lowerBound, replacedDayReward int64
+		age, replacedAge                                          abi.ChainEpoch
+		expect                                                    int64
+	}{
+		"ten days":              {dayReward: 100, twentyDayReward: 1000, age: 10 * day, expect: 1500},
+		"age capped":            {dayReward: 100, twentyDayReward: 1000, age: 200 * day, expect: 8000},
+		"replaced age capped":   {dayReward: 100, twentyDayReward: 1000, age: 100 * day, replacedDayReward: 50, replacedAge: 60 * day, expect: 7000},
+		"lower bound":           {dayReward: 100, twentyDayReward: 1000, age: 10 * day, lowerBound: 10000, expect: 10000},
+		"partial day truncated": {dayReward: 100, twentyDayReward: 0, age: day + 1, expect: 50},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := pledgePenaltyForTermination(abi.NewTokenAmount(tc.dayReward), tc.age, abi.NewTokenAmount(tc.twentyDayReward),
+				abi.NewTokenAmount(tc.lowerBound), abi.NewTokenAmount(tc.replacedDayReward), tc.replacedAge)
+			require.Equal(t, abi.NewTokenAmount(tc.expect), got)
+		})
+	}
+}
+
+func TestPenaltyParametersMatchSpecsActors(t *testing.T) {
+	tf.UnitTest(t)
+
+	require.Equal(t, miner7.ContinuedFaultProjectionPeriod, ContinuedFaultProjectionPeriod)
+	require.Equal(t, miner7.TerminationPenaltyLowerBoundProjectionPeriod, TerminationPenaltyLowerBoundProjectionPeriod)
+
+	reward, power := rewardEstimate(300)
+	reward7 := smoothing7.FilterEstimate{PositionEstimate: reward.PositionEstimate, VelocityEstimate: reward.VelocityEstimate}
+	power7 := smoothing7.FilterEstimate{PositionEstimate: power.PositionEstimate, VelocityEstimate: power.VelocityEstimate}
+	expect := miner7.PledgePenaltyForTermination(abi.NewTokenAmount(100), 100*day, abi.NewTokenAmount(1000), power7,
+		big.NewInt(1<<35), reward7, abi.NewTokenAmount(50), 60*day)
+	got := pledgePenaltyForTermination(abi.NewTokenAmount(100), 100*day, abi.NewTokenAmount(1000), abi.NewTokenAmount(300),
+		abi.NewTokenAmount(50), 60*day)
+	require.Equal(t, expect, got)
+}
+
+func TestSectorFees(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	store := adt.WrapStore(ctx, cbor.NewCborStore(blockstore.NewMemory()))
+	reward, power := rewardEstimate(300)
+	sealed, err := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: multihash.IDENTITY, MhLength: -1}.Sum([]byte("sealed"))
+	require.NoError(t, err)
+
+	// a sector activated at day 10, extended at day 50, so its power counts from then
+	sectors13, err := adt13.MakeEmptyArray(store, miner13.SectorsAmtBitwidth)
+	require.NoError(t, err)
+	require.NoError(t, sectors13.Set(1, &miner13.SectorOnChainInfo{
+		SectorNumber:          1,
+		SealProof:             abi.RegisteredSealProof_StackedDrg32GiBV1_1,
+		SealedCID:             sealed,
+		Activation:            10 * day,
+		Expiration:            500 * day,
+		DealWeight:            big.Zero(),
+		VerifiedDealWeight:    big.Zero(),
+		InitialPledge:         big.Zero(),
+		ExpectedDayReward:     abi.NewTokenAmount(100),
+		ExpectedStoragePledge: abi.NewTokenAmount(1000),
+		PowerBaseEpoch:        50 * day,
+		ReplacedDayReward:     abi.NewTokenAmount(80),
+	}))
+	root13, err := sectors13.Root()
+	require.NoError(t, err)
+	st13 := &state13{State: miner13.State{Sectors: root13}, store: store}
+
+	// the same sector in actors v11 counts its age from activation
+	sectors11, err := adt11.MakeEmptyArray(store, miner11.SectorsAmtBitwidth)
+	require.NoError(t, err)
+	require.NoError(t, sectors11.Set(1, &miner11.SectorOnChainInfo{
+		SectorNumber:          1,
+		SealProof:             abi.RegisteredSealProof_StackedDrg32GiBV1_1,
+		SealedCID:             sealed,
+		Activation:            10 * day,
+		Expiration:            500 * day,
+		DealWeight:            big.Zero(),
+		VerifiedDealWeight:    big.Zero(),
+		InitialPledge:         big.Zero(),
+		ExpectedDayReward:     abi.NewTokenAmount(100),
+		ExpectedStoragePledge: abi.NewTokenAmount(1000),
+		ReplacedDayReward:     big.Zero(),
+	}))
+	root11, err := sectors11.Root()
+	require.NoError(t, err)
+	st11 := &state11{State: miner11.State{Sectors: root11}, store: store}
+
+	for _, st := range []State{st11, st13} {
+		fee, err := st.FaultFee([]abi.SectorNumber{1}, reward, power)
+		require.NoError(t, err)
+		require.Equal(t, abi.NewTokenAmount(300), fee)
+
+		_, err = st.FaultFee([]abi.SectorNumber{2}, reward, power)
+		require.EqualError(t, err, "sector 2 not found")
+	}
+
+	// v11: 1000 + 100 * 60 days / 2
+	fee, err := st11.TerminationFee([]abi.SectorNumber{1}, 70*day, reward, power)
+	require.NoError(t, err)
+	require.Equal(t, abi.NewTokenAmount(4000), fee)
+
+	// v13: 1000 + (100 * 20 days + 80 * 40 days) / 2
+	fee, err = st13.TerminationFee([]abi.SectorNumber{1}, 70*day, reward, power)
+	require.NoError(t, err)
+	require.Equal(t, abi.NewTokenAmount(3600), fee)
+}
@@ -7,11 +7,10 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/filecoin-project/go-state-types/big"
-
 	"github.com/filecoin-project/go-bitfield"
 	rle "github.com/filecoin-project/go-bitfield/rle"
 	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/go-state-types/dline"
 	"github.com/ipfs/go-cid"
 	cbg "github.com/whyrusleeping/cbor-gen"
@@ -20,6 +19,7 @@ import (
 	"github.com/filecoin-project/go-state-types/manifest"
 	"github.com/filecoin-project/venus/venus-shared/actors"
 	"github.com/filecoin-project/venus/venus-shared/actors/adt"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin"
 
 	miner0 "github.com/filecoin-project/specs-actors/actors/builtin/miner"
 	adt0 "github.com/filecoin-project/specs-actors/actors/util/adt"
@@ -104,6 +104,14 @@ func (s *state0) GetSector(num abi.SectorNumber) (*SectorOnChainInfo, error) {
 	return &ret, nil
 }
 
+func (s *state0) FaultFee(sectorNos []abi.SectorNumber, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error) {
+	return big.Zero(), fmt.Errorf("fault fee is not supported for actors v0")
+}
+
+func (s *state0) TerminationFee(sectorNos []abi.SectorNumber, epoch abi.ChainEpoch, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error) {
+	return big.Zero(), fmt.Errorf("termination fee is not supported for actors v0")
+}
+
 func (s *state0) FindSector(num abi.SectorNumber) (*SectorLocation, error) {
 	dlIdx, partIdx, err := s.State.FindSector(s.store, num)
 	if err != nil {
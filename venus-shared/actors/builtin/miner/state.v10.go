@@ -10,6 +10,7 @@ import (
 	"github.com/filecoin-project/go-bitfield"
 	rle "github.com/filecoin-project/go-bitfield/rle"
 	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/go-state-types/dline"
 	"github.com/ipfs/go-cid"
 	cbg "github.com/whyrusleeping/cbor-gen"
@@ -18,10 +19,12 @@ import (
 	"github.com/filecoin-project/go-state-types/manifest"
 	"github.com/filecoin-project/venus/venus-shared/actors"
 	"github.com/filecoin-project/venus/venus-shared/actors/adt"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin"
 
 	builtin10 "github.com/filecoin-project/go-state-types/builtin"
 	miner10 "github.com/filecoin-project/go-state-types/builtin/v10/miner"
 	adt10 "github.com/filecoin-project/go-state-types/builtin/v10/util/adt"
+	smoothing10 "github.com/filecoin-project/go-state-types/builtin/v10/util/smoothing"
 )
 
 var _ State = (*state10)(nil)
@@ -103,6 +106,54 @@ func (s *state10) GetSector(num abi.SectorNumber) (*SectorOnChainInfo, error) {
 	return &ret, nil
 }
 
+func (s *state10) FaultFee(sectorNos []abi.SectorNumber, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error) {
+	reward := smoothing10.FilterEstimate{PositionEstimate: rewardEstimate.PositionEstimate, VelocityEstimate: rewardEstimate.VelocityEstimate}
+	power := smoothing10.FilterEstimate{PositionEstimate: networkQAPowerEstimate.PositionEstimate, VelocityEstimate: networkQAPowerEstimate.VelocityEstimate}
+
+	total := big.Zero()
+	for _, num := range sectorNos {
+		info, ok, err := s.State.GetSector(s.store, num)
+		if err != nil {
+			return big.Zero(), fmt.Errorf("loading sector %d: %w", num, err)
+		}
+		if !ok {
+			return big.Zero(), fmt.Errorf("sector %d not found", num)
+		}
+		ssize, err := info.SealProof.SectorSize()
+		if err != nil {
+			return big.Zero(), err
+		}
+		qaPower := miner10.QAPowerForSector(ssize, info)
+		total = big.Add(total, miner10.ExpectedRewardForPower(reward, power, qaPower, ContinuedFaultProjectionPeriod))
+	}
+	return total, nil
+}
+
+func (s *state10) TerminationFee(sectorNos []abi.SectorNumber, epoch abi.ChainEpoch, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error) {
+	reward := smoothing10.FilterEstimate{PositionEstimate: rewardEstimate.PositionEstimate, VelocityEstimate: rewardEstimate.VelocityEstimate}
+	power := smoothing10.FilterEstimate{PositionEstimate: networkQAPowerEstimate.PositionEstimate, VelocityEstimate: networkQAPowerEstimate.VelocityEstimate}
+
+	total := big.Zero()
+	for _, num := range sectorNos {
+		info, ok, err := s.State.GetSector(s.store, num)
+		if err != nil {
+			return big.Zero(), fmt.Errorf("loading sector %d: %w", num, err)
+		}
+		if !ok {
+			return big.Zero(), fmt.Errorf("sector %d not found", num)
+		}
+		ssize, err := info.SealProof.SectorSize()
+		if err != nil {
+			return big.Zero(), err
+		}
+		qaPower := miner10.QAPowerForSector(ssize, info)
+		lowerBound := miner10.ExpectedRewardForPower(reward, power, qaPower, TerminationPenaltyLowerBoundProjectionPeriod)
+		total = big.Add(total, pledgePenaltyForTermination(info.ExpectedDayReward, epoch-info.Activation, info.ExpectedStoragePledge,
+			lowerBound, info.ReplacedDayReward, info.ReplacedSectorAge))
+	}
+	return total, nil
+}
+
 func (s *state10) FindSector(num abi.SectorNumber) (*SectorLocation, error) {
 	dlIdx, partIdx, err := s.State.FindSector(s.store, num)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/filecoin-project/go-bitfield"
 	rle "github.com/filecoin-project/go-bitfield/rle"
 	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/go-state-types/dline"
 	"github.com/ipfs/go-cid"
 	cbg "github.com/whyrusleeping/cbor-gen"
@@ -18,9 +19,12 @@ import (
 	"github.com/filecoin-project/go-state-types/manifest"
 	"github.com/filecoin-project/venus/venus-shared/actors"
 	"github.com/filecoin-project/venus/venus-shared/actors/adt"
+	"github.com/filecoin-project/venus/venus-shared/actors/builtin"
 
 	miner2 "github.com/filecoin-project/specs-actors/v2/actors/builtin/miner"
 	adt2 "github.com/filecoin-project/specs-actors/v2/actors/util/adt"
+
+	smoothing2 "github.com/filecoin-project/specs-actors/v2/actors/util/smoothing"
 )
 
 var _ State = (*state2)(nil)
@@ -102,6 +106,53 @@ func (s *state2) GetSector(num abi.SectorNumber) (*SectorOnChainInfo, error) {
 	return &ret, nil
 }
 
+func (s *state2) FaultFee(sectorNos []abi.SectorNumber, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error) {
+	reward := smoothing2.FilterEstimate{PositionEstimate: rewardEstimate.PositionEstimate, VelocityEstimate: rewardEstimate.VelocityEstimate}
+	power := smoothing2.FilterEstimate{PositionEstimate: networkQAPowerEstimate.PositionEstimate, VelocityEstimate: networkQAPowerEstimate.VelocityEstimate}
+
+	total := big.Zero()
+	for _, num := range sectorNos {
+		info, ok, err := s.State.GetSector(s.store, num)
+		if err != nil {
+			return big.Zero(), fmt.Errorf("loading sector %d: %w", num, err)
+		}
+		if !ok {
+			return big.Zero(), fmt.Errorf("sector %d not found", num)
+		}
+		ssize, err := info.SealProof.SectorSize()
+		if err != nil {
+			return big.Zero(), err
+		}
+		qaPower := miner2.QAPowerForSector(ssize, info)
+		total = big.Add(total, miner2.PledgePenaltyForContinuedFault(reward, power, qaPower))
+	}
+	return total, nil
+}
+
+func (s *state2) TerminationFee(sectorNos []abi.SectorNumber, epoch abi.ChainEpoch, rewardEstimate, networkQAPowerEstimate builtin.FilterEstimate) (abi.TokenAmount, error) {
+	reward := smoothing2.FilterEstimate{PositionEstimate: rewardEstimate.PositionEstimate, VelocityEstimate: rewardEstimate.VelocityEstimate}
+	power := smoothing2.FilterEstimate{PositionEstimate: networkQAPowerEstimate.PositionEstimate, VelocityEstimate: networkQAPowerEstimate.VelocityEstimate}
+
+	total := big.Zero()
+	for _, num := range sectorNos {
+		info, ok, err := s.State.GetSector(s.store, num)
+		if err != nil {
+			return big.Zero(), fmt.Errorf("loading sector %d: %w", num, err)
+		}
+		if !ok {
+			return big.Zero(), fmt.Errorf("sector %d not found", num)
+		}
+		ssize, err := info.SealProof.SectorSize()
+		if err != nil {
+			return big.Zero(), err
+		}
+		qaPower := miner2.QAPowerForSector(ssize, info)
+		total = big.Add(total, miner2.PledgePenaltyForTermination(info.ExpectedDayReward, epoch-info.Activation, info.ExpectedStoragePledge,
+			power, qaPower, reward, info.ReplacedDayReward, info.ReplacedSectorAge))
+	}
+	return total, nil
+}
+
 func (s *state2) FindSector(num abi.SectorNumber) (*SectorLocation, error) {
 	dlIdx, partIdx, err := s.State.FindSector(s.store, num)
 	if err != nil {
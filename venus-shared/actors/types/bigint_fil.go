@@ -3,6 +3,7 @@ package types
 import (
 	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -112,19 +113,34 @@ func (f *FIL) UnmarshalJSON(by []byte) error {
 	return nil
 }
 
+// filDenominations maps the accepted unit suffixes to the number of attoFIL in one unit.
+var filDenominations = map[string]int64{
+	"":         1e18,
+	"fil":      1e18,
+	"millifil": 1e15,
+	"mfil":     1e15,
+	"microfil": 1e12,
+	"μfil":     1e12,
+	"ufil":     1e12,
+	"nanofil":  1e9,
+	"nfil":     1e9,
+	"picofil":  1e6,
+	"pfil":     1e6,
+	"femtofil": 1e3,
+	"ffil":     1e3,
+	"attofil":  1,
+	"afil":     1,
+}
+
+// ParseFIL parses a decimal value with an optional denomination suffix, eg. "1.5 FIL", "100 nanoFIL"
+// or "10 aFIL". Values without a suffix are interpreted as FIL.
 func ParseFIL(s string) (FIL, error) {
 	suffix := strings.TrimLeft(s, "-.1234567890")
 	s = s[:len(s)-len(suffix)]
-	var attofil bool
-	if suffix != "" {
-		norm := strings.ToLower(strings.TrimSpace(suffix))
-		switch norm {
-		case "", "fil":
-		case "attofil", "afil":
-			attofil = true
-		default:
-			return FIL{}, fmt.Errorf("unrecognized suffix: %q", suffix)
-		}
+	norm := strings.ToLower(strings.TrimSpace(suffix))
+	unit, ok := filDenominations[norm]
+	if !ok {
+		return FIL{}, fmt.Errorf("unrecognized suffix: %q", suffix)
 	}
 
 	if len(s) > 50 {
@@ -136,21 +152,28 @@ func ParseFIL(s string) (FIL, error) {
 		return FIL{}, fmt.Errorf("failed to parse %q as a decimal number", s)
 	}
 
-	if !attofil {
-		r = r.Mul(r, big.NewRat(int64(params.FilecoinPrecision), 1))
-	}
+	r = r.Mul(r, big.NewRat(unit, 1))
 
 	if !r.IsInt() {
-		var pref string
-		if attofil {
-			pref = "atto"
-		}
-		return FIL{}, fmt.Errorf("invalid %sFIL value: %q", pref, s)
+		return FIL{}, fmt.Errorf("invalid %s value: %q, the smallest unit is 1 attoFIL", strings.TrimSpace(suffix), s)
 	}
 
 	return FIL{r.Num()}, nil
 }
 
+// ParseNonNegativeFIL is like ParseFIL but also rejects negative values and values larger than
+// the total supply of FIL, it should be used for every user provided value field.
+func ParseNonNegativeFIL(s string) (FIL, error) {
+	f, err := ParseFIL(s)
+	if err != nil {
+		return FIL{}, err
+	}
+	if err := f.Validate(); err != nil {
+		return FIL{}, err
+	}
+	return f, nil
+}
+
 func MustParseFIL(s string) FIL {
 	n, err := ParseFIL(s)
 	if err != nil {
@@ -164,6 +187,64 @@ func FromFil(i uint64) BigInt {
 	return BigMul(NewInt(i), NewInt(params.FilecoinPrecision))
 }
 
+var (
+	ErrNegativeFIL = errors.New("FIL amount must not be negative")
+	ErrFILOverflow = errors.New("FIL amount exceeds the total supply")
+)
+
+// MaxFIL is the total supply of FIL, no valid amount can be larger than it.
+var MaxFIL = FIL(FromFil(params.FilBase))
+
+// Validate checks that the amount is non-negative and does not exceed the total supply.
+func (f FIL) Validate() error {
+	if f.Int == nil {
+		return nil
+	}
+	if f.Int.Sign() < 0 {
+		return ErrNegativeFIL
+	}
+	if f.Int.Cmp(MaxFIL.Int) > 0 {
+		return ErrFILOverflow
+	}
+	return nil
+}
+
+// CheckedAdd returns f+o, failing if either operand or the result is not a valid amount.
+func (f FIL) CheckedAdd(o FIL) (FIL, error) {
+	return checkedFILOp(f, o, BigAdd)
+}
+
+// CheckedSub returns f-o, failing if either operand or the result is not a valid amount.
+func (f FIL) CheckedSub(o FIL) (FIL, error) {
+	return checkedFILOp(f, o, BigSub)
+}
+
+// CheckedMul returns f*n, failing if f or the result is not a valid amount.
+func (f FIL) CheckedMul(n uint64) (FIL, error) {
+	return checkedFILOp(f, FIL(NewInt(n)), BigMul)
+}
+
+func checkedFILOp(a, b FIL, op func(BigInt, BigInt) BigInt) (FIL, error) {
+	if err := a.Validate(); err != nil {
+		return FIL{}, err
+	}
+	if err := b.Validate(); err != nil {
+		return FIL{}, err
+	}
+	res := FIL(op(filOrZero(a), filOrZero(b)))
+	if err := res.Validate(); err != nil {
+		return FIL{}, err
+	}
+	return res, nil
+}
+
+func filOrZero(f FIL) BigInt {
+	if f.Int == nil {
+		return NewInt(0)
+	}
+	return BigInt(f)
+}
+
 var (
 	_ encoding.TextMarshaler   = (*FIL)(nil)
 	_ encoding.TextUnmarshaler = (*FIL)(nil)
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/filecoin-project/venus/venus-shared/types"
+)
+
+// IAdmin groups the operations which rewrite the chain state of the node or stop it. Each one is
+// refused unless confirm is set, and every call is written to the admin-audit log.
+type IAdmin interface {
+	// AdminSetHead moves the head of the node to the tipset of key, replacing ChainSetHead.
+	AdminSetHead(ctx context.Context, key types.TipSetKey, confirm bool) error //perm:admin
+	// AdminCheckpoint persists the tipset of key as the checkpoint. It must be in the chain of the
+	// head, and the node then refuses to sync a chain which doesn't pass through it. AdminSetHead
+	// isn't checked against the checkpoint.
+	AdminCheckpoint(ctx context.Context, key types.TipSetKey, confirm bool) error //perm:admin
+	// AdminDeleteObj deletes an object from the blockstore, replacing ChainDeleteObj.
+	AdminDeleteObj(ctx context.Context, obj cid.Cid, confirm bool) error //perm:admin
+	// AdminShutdown stops the node gracefully.
+	AdminShutdown(ctx context.Context, confirm bool) error //perm:admin
+}
@@ -9,7 +9,8 @@ import (
 )
 
 type IBlockStore interface {
-	ChainReadObj(ctx context.Context, cid cid.Cid) ([]byte, error)                      //perm:read
+	ChainReadObj(ctx context.Context, cid cid.Cid) ([]byte, error) //perm:read
+	// Deprecated: ChainDeleteObj skips the confirm and the audit log, use AdminDeleteObj.
 	ChainDeleteObj(ctx context.Context, obj cid.Cid) error                              //perm:admin
 	ChainHasObj(ctx context.Context, obj cid.Cid) (bool, error)                         //perm:read
 	ChainStatObj(ctx context.Context, obj cid.Cid, base cid.Cid) (types.ObjStat, error) //perm:read
@@ -36,10 +36,15 @@ type IActor interface {
 }
 
 type IChainInfo interface {
-	BlockTime(ctx context.Context) time.Duration                                                                                                                                          //perm:read
-	ChainList(ctx context.Context, tsKey types.TipSetKey, count int) ([]types.TipSetKey, error)                                                                                           //perm:read
-	ChainHead(ctx context.Context) (*types.TipSet, error)                                                                                                                                 //perm:read
-	ChainSetHead(ctx context.Context, key types.TipSetKey) error                                                                                                                          //perm:admin
+	BlockTime(ctx context.Context) time.Duration                                                //perm:read
+	ChainList(ctx context.Context, tsKey types.TipSetKey, count int) ([]types.TipSetKey, error) //perm:read
+	ChainHead(ctx context.Context) (*types.TipSet, error)                                       //perm:read
+	// Deprecated: ChainSetHead skips the confirm and the audit log, use AdminSetHead.
+	ChainSetHead(ctx context.Context, key types.TipSetKey) error //perm:admin
+	// ChainFsck executes again the tipsets of the chain between from and to, comparing the state and
+	// receipt roots with the ones their child tipset commits to and the ones stored by the node.
+	// With repair the stored results found wrong are replaced, mismatches with the chain can't be.
+	ChainFsck(ctx context.Context, from, to abi.ChainEpoch, repair bool) ([]types.ChainFsckIssue, error)                                                                                  //perm:admin
 	ChainGetTipSet(ctx context.Context, key types.TipSetKey) (*types.TipSet, error)                                                                                                       //perm:read
 	ChainGetTipSetByHeight(ctx context.Context, height abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error)                                                                        //perm:read
 	ChainGetTipSetAfterHeight(ctx context.Context, height abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error)                                                                     //perm:read
@@ -52,11 +57,15 @@ type IChainInfo interface {
 	// StateGetBeaconEntry returns the beacon entry for the given filecoin epoch. If
 	// the entry has not yet been produced, the call will block until the entry
 	// becomes available
-	StateGetBeaconEntry(ctx context.Context, epoch abi.ChainEpoch) (*types.BeaconEntry, error)                     //perm:read
-	ChainGetBlock(ctx context.Context, id cid.Cid) (*types.BlockHeader, error)                                     //perm:read
-	ChainGetMessage(ctx context.Context, msgID cid.Cid) (*types.Message, error)                                    //perm:read
-	ChainGetBlockMessages(ctx context.Context, bid cid.Cid) (*types.BlockMessages, error)                          //perm:read
-	ChainGetMessagesInTipset(ctx context.Context, key types.TipSetKey) ([]types.MessageCID, error)                 //perm:read
+	StateGetBeaconEntry(ctx context.Context, epoch abi.ChainEpoch) (*types.BeaconEntry, error) //perm:read
+	// BeaconGetStatus reports the health of the servers of the drand networks of the beacon schedule.
+	BeaconGetStatus(ctx context.Context) ([]types.DrandStatus, error)                              //perm:read
+	ChainGetBlock(ctx context.Context, id cid.Cid) (*types.BlockHeader, error)                     //perm:read
+	ChainGetMessage(ctx context.Context, msgID cid.Cid) (*types.Message, error)                    //perm:read
+	ChainGetBlockMessages(ctx context.Context, bid cid.Cid) (*types.BlockMessages, error)          //perm:read
+	ChainGetMessagesInTipset(ctx context.Context, key types.TipSetKey) ([]types.MessageCID, error) //perm:read
+	// ChainGetDecodedMessagesInTipset returns the messages executed in a tipset with their receipts and decoded params
+	ChainGetDecodedMessagesInTipset(ctx context.Context, key types.TipSetKey) ([]types.DecodedMessage, error)      //perm:read
 	ChainGetReceipts(ctx context.Context, id cid.Cid) ([]types.MessageReceipt, error)                              //perm:read
 	ChainGetParentMessages(ctx context.Context, bcid cid.Cid) ([]types.MessageCID, error)                          //perm:read
 	ChainGetParentReceipts(ctx context.Context, bcid cid.Cid) ([]*types.MessageReceipt, error)                     //perm:read
@@ -110,6 +119,9 @@ type IChainInfo interface {
 	VerifyEntry(parent, child *types.BeaconEntry, height abi.ChainEpoch) bool                                                             //perm:read
 	ChainExport(context.Context, abi.ChainEpoch, bool, types.TipSetKey) (<-chan []byte, error)                                            //perm:read
 	ChainGetPath(ctx context.Context, from types.TipSetKey, to types.TipSetKey) ([]*types.HeadChange, error)                              //perm:read
+	// ChainSnapshotStatus reports the periodic chain snapshot export configured in the node and
+	// the snapshots it keeps.
+	ChainSnapshotStatus(ctx context.Context) (*types.SnapshotStatus, error) //perm:read
 	// StateGetNetworkParams return current network params
 	StateGetNetworkParams(ctx context.Context) (*types.NetworkParams, error) //perm:read
 	// StateActorCodeCIDs returns the CIDs of all the builtin actors for the given network version
@@ -119,7 +131,12 @@ type IChainInfo interface {
 	// StateActorManifestCID returns the CID of the builtin actors manifest for the given network version
 	StateActorManifestCID(context.Context, network.Version) (cid.Cid, error)                            //perm:read
 	StateCall(ctx context.Context, msg *types.Message, tsk types.TipSetKey) (*types.InvocResult, error) //perm:read
-	StateReplay(context.Context, types.TipSetKey, cid.Cid) (*types.InvocResult, error)                  //perm:read
+	// StateCallWithWitness runs the message like StateCall and also returns, as a CAR rooted at
+	// the parent state of the tipset, the state tree nodes the execution read. The witness is
+	// enough to replay the call only when the sender has no messages in the tipset, no state
+	// migration runs at its height and the call doesn't read the lookback state or randomness.
+	StateCallWithWitness(ctx context.Context, msg *types.Message, tsk types.TipSetKey) (*types.CallWitness, error) //perm:read
+	StateReplay(context.Context, types.TipSetKey, cid.Cid) (*types.InvocResult, error)                             //perm:read
 	// ChainGetEvents returns the events under an event AMT root CID.
 	ChainGetEvents(context.Context, cid.Cid) ([]types.Event, error) //perm:read
 	// StateCompute is a flexible command that applies the given messages on the given tipset.
@@ -172,17 +189,23 @@ type IMinerState interface {
 	StateSectorPreCommitInfo(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorPreCommitOnChainInfo, error) //perm:read
 	StateSectorGetInfo(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorOnChainInfo, error)                //perm:read
 	StateSectorPartition(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorLocation, error)     //perm:read
-	StateMinerSectorSize(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (abi.SectorSize, error)                                            //perm:read
-	StateMinerInfo(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (types.MinerInfo, error)                                                 //perm:read
-	StateMinerWorkerAddress(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (address.Address, error)                                        //perm:read
-	StateMinerFaults(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (bitfield.BitField, error)                                             //perm:read
-	StateAllMinerFaults(ctx context.Context, lookback abi.ChainEpoch, ts types.TipSetKey) ([]*types.Fault, error)                                            //perm:read
-	StateMinerRecoveries(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (bitfield.BitField, error)                                         //perm:read
-	StateMinerProvingDeadline(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (*dline.Info, error)                                          //perm:read
-	StateMinerPartitions(ctx context.Context, maddr address.Address, dlIdx uint64, tsk types.TipSetKey) ([]types.Partition, error)                           //perm:read
-	StateMinerDeadlines(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]types.Deadline, error)                                           //perm:read
-	StateMinerSectors(ctx context.Context, maddr address.Address, sectorNos *bitfield.BitField, tsk types.TipSetKey) ([]*types.SectorOnChainInfo, error)     //perm:read
-	StateMarketStorageDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.MarketDeal, error)                                           //perm:read
+	// StateSectorStatus returns whether the sector number is allocated, precommitted or proven,
+	// with its precommit or on-chain info, read from a single state.
+	StateSectorStatus(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorStatus, error)                  //perm:read
+	StateMinerSectorSize(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (abi.SectorSize, error)                                        //perm:read
+	StateMinerInfo(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (types.MinerInfo, error)                                             //perm:read
+	StateMinerWorkerAddress(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (address.Address, error)                                    //perm:read
+	StateMinerFaults(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (bitfield.BitField, error)                                         //perm:read
+	StateAllMinerFaults(ctx context.Context, lookback abi.ChainEpoch, ts types.TipSetKey) ([]*types.Fault, error)                                        //perm:read
+	StateMinerRecoveries(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (bitfield.BitField, error)                                     //perm:read
+	StateMinerProvingDeadline(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (*dline.Info, error)                                      //perm:read
+	StateMinerPartitions(ctx context.Context, maddr address.Address, dlIdx uint64, tsk types.TipSetKey) ([]types.Partition, error)                       //perm:read
+	StateMinerDeadlines(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]types.Deadline, error)                                       //perm:read
+	StateMinerSectors(ctx context.Context, maddr address.Address, sectorNos *bitfield.BitField, tsk types.TipSetKey) ([]*types.SectorOnChainInfo, error) //perm:read
+	StateMarketStorageDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.MarketDeal, error)                                       //perm:read
+	// StateDealSector returns the sector the deal was activated in. It fails if the deal isn't
+	// activated yet, and before network version 22 as the market didn't keep the sector of deals.
+	StateDealSector(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.DealSector, error) //perm:read
 	// StateGetAllocationForPendingDeal returns the allocation for a given deal ID of a pending deal. Returns nil if
 	// pending allocation is not found.
 	StateGetAllocationForPendingDeal(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.Allocation, error) //perm:read
@@ -204,15 +227,24 @@ type IMinerState interface {
 	StateComputeDataCID(ctx context.Context, maddr address.Address, sectorType abi.RegisteredSealProof, deals []abi.DealID, tsk types.TipSetKey) (cid.Cid, error) //perm:read
 	StateMinerPreCommitDepositForPower(ctx context.Context, maddr address.Address, pci types.SectorPreCommitInfo, tsk types.TipSetKey) (big.Int, error)           //perm:read
 	StateMinerInitialPledgeCollateral(ctx context.Context, maddr address.Address, pci types.SectorPreCommitInfo, tsk types.TipSetKey) (big.Int, error)            //perm:read
-	StateVMCirculatingSupplyInternal(ctx context.Context, tsk types.TipSetKey) (types.CirculatingSupply, error)                                                   //perm:read
-	StateCirculatingSupply(ctx context.Context, tsk types.TipSetKey) (abi.TokenAmount, error)                                                                     //perm:read
-	StateMarketDeals(ctx context.Context, tsk types.TipSetKey) (map[string]*types.MarketDeal, error)                                                              //perm:read
-	StateMinerActiveSectors(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]*types.SectorOnChainInfo, error)                                  //perm:read
-	StateLookupID(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error)                                                        //perm:read
+	// StateMinerFaultFee returns the penalty charged for each proving period the given sectors stay faulty
+	StateMinerFaultFee(ctx context.Context, maddr address.Address, sectors []abi.SectorNumber, tsk types.TipSetKey) (big.Int, error) //perm:read
+	// StateMinerTerminationFee returns the penalty charged for terminating the given sectors at the tipset
+	StateMinerTerminationFee(ctx context.Context, maddr address.Address, sectors []abi.SectorNumber, tsk types.TipSetKey) (big.Int, error) //perm:read
+	StateVMCirculatingSupplyInternal(ctx context.Context, tsk types.TipSetKey) (types.CirculatingSupply, error)                            //perm:read
+	StateCirculatingSupply(ctx context.Context, tsk types.TipSetKey) (abi.TokenAmount, error)                                              //perm:read
+	StateMarketDeals(ctx context.Context, tsk types.TipSetKey) (map[string]*types.MarketDeal, error)                                       //perm:read
+	// StateMarketDealStateChanges returns the deals whose state changed between the parent states of the two tipsets
+	StateMarketDealStateChanges(ctx context.Context, from, to types.TipSetKey) ([]types.MarketDealStateChange, error)            //perm:read
+	StateMinerActiveSectors(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]*types.SectorOnChainInfo, error) //perm:read
+	StateLookupID(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error)                       //perm:read
 	// StateLookupRobustAddress returns the public key address of the given ID address for non-account addresses (multisig, miners etc)
-	StateLookupRobustAddress(context.Context, address.Address, types.TipSetKey) (address.Address, error)                                                     //perm:read
-	StateListMiners(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                                                                     //perm:read
-	StateListActors(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                                                                     //perm:read
+	StateLookupRobustAddress(context.Context, address.Address, types.TipSetKey) (address.Address, error) //perm:read
+	StateListMiners(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                 //perm:read
+	StateListActors(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                 //perm:read
+	// StateListActorsByCode returns up to limit addresses of the actors in the state starting at offset,
+	// only those with the given code cid unless code is cid.Undef.
+	StateListActorsByCode(ctx context.Context, code cid.Cid, offset, limit int, tsk types.TipSetKey) ([]address.Address, error)                              //perm:read
 	StateMinerPower(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.MinerPower, error)                                               //perm:read
 	StateMinerAvailableBalance(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (big.Int, error)                                             //perm:read
 	StateSectorExpiration(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorExpiration, error)  //perm:read
@@ -12,4 +12,6 @@ import (
 type IMining interface {
 	MinerGetBaseInfo(ctx context.Context, maddr address.Address, round abi.ChainEpoch, tsk types.TipSetKey) (*types.MiningBaseInfo, error) //perm:read
 	MinerCreateBlock(ctx context.Context, bt *types.BlockTemplate) (*types.BlockMsg, error)                                                //perm:write
+	// MinerGetStats returns the statistics of the miners listed in the miningStats config.
+	MinerGetStats(ctx context.Context) ([]types.MinerStats, error) //perm:read
 }
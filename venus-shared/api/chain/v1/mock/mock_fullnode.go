@@ -56,6 +56,77 @@ func (m *MockFullNode) EXPECT() *MockFullNodeMockRecorder {
 	return m.recorder
 }
 
+// AdminCheckpoint mocks base method.
+func (m *MockFullNode) AdminCheckpoint(arg0 context.Context, arg1 types0.TipSetKey, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminCheckpoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminCheckpoint indicates an expected call of AdminCheckpoint.
+func (mr *MockFullNodeMockRecorder) AdminCheckpoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminCheckpoint", reflect.TypeOf((*MockFullNode)(nil).AdminCheckpoint), arg0, arg1, arg2)
+}
+
+// AdminDeleteObj mocks base method.
+func (m *MockFullNode) AdminDeleteObj(arg0 context.Context, arg1 cid.Cid, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminDeleteObj", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminDeleteObj indicates an expected call of AdminDeleteObj.
+func (mr *MockFullNodeMockRecorder) AdminDeleteObj(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminDeleteObj", reflect.TypeOf((*MockFullNode)(nil).AdminDeleteObj), arg0, arg1, arg2)
+}
+
+// AdminSetHead mocks base method.
+func (m *MockFullNode) AdminSetHead(arg0 context.Context, arg1 types0.TipSetKey, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminSetHead", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminSetHead indicates an expected call of AdminSetHead.
+func (mr *MockFullNodeMockRecorder) AdminSetHead(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminSetHead", reflect.TypeOf((*MockFullNode)(nil).AdminSetHead), arg0, arg1, arg2)
+}
+
+// AdminShutdown mocks base method.
+func (m *MockFullNode) AdminShutdown(arg0 context.Context, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminShutdown", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdminShutdown indicates an expected call of AdminShutdown.
+func (mr *MockFullNodeMockRecorder) AdminShutdown(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminShutdown", reflect.TypeOf((*MockFullNode)(nil).AdminShutdown), arg0, arg1)
+}
+
+// BeaconGetStatus mocks base method.
+func (m *MockFullNode) BeaconGetStatus(arg0 context.Context) ([]types0.DrandStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeaconGetStatus", arg0)
+	ret0, _ := ret[0].([]types0.DrandStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeaconGetStatus indicates an expected call of BeaconGetStatus.
+func (mr *MockFullNodeMockRecorder) BeaconGetStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconGetStatus", reflect.TypeOf((*MockFullNode)(nil).BeaconGetStatus), arg0)
+}
+
 // BlockTime mocks base method.
 func (m *MockFullNode) BlockTime(arg0 context.Context) time.Duration {
 	m.ctrl.T.Helper()
@@ -99,6 +170,21 @@ func (mr *MockFullNodeMockRecorder) ChainExport(arg0, arg1, arg2, arg3 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainExport", reflect.TypeOf((*MockFullNode)(nil).ChainExport), arg0, arg1, arg2, arg3)
 }
 
+// ChainFsck mocks base method.
+func (m *MockFullNode) ChainFsck(arg0 context.Context, arg1, arg2 abi.ChainEpoch, arg3 bool) ([]types0.ChainFsckIssue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainFsck", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]types0.ChainFsckIssue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainFsck indicates an expected call of ChainFsck.
+func (mr *MockFullNodeMockRecorder) ChainFsck(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainFsck", reflect.TypeOf((*MockFullNode)(nil).ChainFsck), arg0, arg1, arg2, arg3)
+}
+
 // ChainGetBlock mocks base method.
 func (m *MockFullNode) ChainGetBlock(arg0 context.Context, arg1 cid.Cid) (*types0.BlockHeader, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +215,21 @@ func (mr *MockFullNodeMockRecorder) ChainGetBlockMessages(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainGetBlockMessages", reflect.TypeOf((*MockFullNode)(nil).ChainGetBlockMessages), arg0, arg1)
 }
 
+// ChainGetDecodedMessagesInTipset mocks base method.
+func (m *MockFullNode) ChainGetDecodedMessagesInTipset(arg0 context.Context, arg1 types0.TipSetKey) ([]types0.DecodedMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainGetDecodedMessagesInTipset", arg0, arg1)
+	ret0, _ := ret[0].([]types0.DecodedMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainGetDecodedMessagesInTipset indicates an expected call of ChainGetDecodedMessagesInTipset.
+func (mr *MockFullNodeMockRecorder) ChainGetDecodedMessagesInTipset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainGetDecodedMessagesInTipset", reflect.TypeOf((*MockFullNode)(nil).ChainGetDecodedMessagesInTipset), arg0, arg1)
+}
+
 // ChainGetEvents mocks base method.
 func (m *MockFullNode) ChainGetEvents(arg0 context.Context, arg1 cid.Cid) ([]types0.Event, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +498,21 @@ func (mr *MockFullNodeMockRecorder) ChainSetHead(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainSetHead", reflect.TypeOf((*MockFullNode)(nil).ChainSetHead), arg0, arg1)
 }
 
+// ChainSnapshotStatus mocks base method.
+func (m *MockFullNode) ChainSnapshotStatus(arg0 context.Context) (*types0.SnapshotStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainSnapshotStatus", arg0)
+	ret0, _ := ret[0].(*types0.SnapshotStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainSnapshotStatus indicates an expected call of ChainSnapshotStatus.
+func (mr *MockFullNodeMockRecorder) ChainSnapshotStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainSnapshotStatus", reflect.TypeOf((*MockFullNode)(nil).ChainSnapshotStatus), arg0)
+}
+
 // ChainStatObj mocks base method.
 func (m *MockFullNode) ChainStatObj(arg0 context.Context, arg1, arg2 cid.Cid) (types0.ObjStat, error) {
 	m.ctrl.T.Helper()
@@ -1293,6 +1409,21 @@ func (mr *MockFullNodeMockRecorder) MinerGetBaseInfo(arg0, arg1, arg2, arg3 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinerGetBaseInfo", reflect.TypeOf((*MockFullNode)(nil).MinerGetBaseInfo), arg0, arg1, arg2, arg3)
 }
 
+// MinerGetStats mocks base method.
+func (m *MockFullNode) MinerGetStats(arg0 context.Context) ([]types0.MinerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MinerGetStats", arg0)
+	ret0, _ := ret[0].([]types0.MinerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MinerGetStats indicates an expected call of MinerGetStats.
+func (mr *MockFullNodeMockRecorder) MinerGetStats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinerGetStats", reflect.TypeOf((*MockFullNode)(nil).MinerGetStats), arg0)
+}
+
 // MpoolBatchPush mocks base method.
 func (m *MockFullNode) MpoolBatchPush(arg0 context.Context, arg1 []*types.SignedMessage) ([]cid.Cid, error) {
 	m.ctrl.T.Helper()
@@ -1411,6 +1542,21 @@ func (mr *MockFullNodeMockRecorder) MpoolDeleteByAdress(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MpoolDeleteByAdress", reflect.TypeOf((*MockFullNode)(nil).MpoolDeleteByAdress), arg0, arg1)
 }
 
+// MpoolGasMarket mocks base method.
+func (m *MockFullNode) MpoolGasMarket(arg0 context.Context, arg1 int) (*types0.GasMarket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MpoolGasMarket", arg0, arg1)
+	ret0, _ := ret[0].(*types0.GasMarket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MpoolGasMarket indicates an expected call of MpoolGasMarket.
+func (mr *MockFullNodeMockRecorder) MpoolGasMarket(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MpoolGasMarket", reflect.TypeOf((*MockFullNode)(nil).MpoolGasMarket), arg0, arg1)
+}
+
 // MpoolGetConfig mocks base method.
 func (m *MockFullNode) MpoolGetConfig(arg0 context.Context) (*types0.MpoolConfig, error) {
 	m.ctrl.T.Helper()
@@ -1588,6 +1734,21 @@ func (mr *MockFullNodeMockRecorder) MpoolSub(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MpoolSub", reflect.TypeOf((*MockFullNode)(nil).MpoolSub), arg0)
 }
 
+// NetAddrsAnnounced mocks base method.
+func (m *MockFullNode) NetAddrsAnnounced(arg0 context.Context) (types0.NetAddrs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetAddrsAnnounced", arg0)
+	ret0, _ := ret[0].(types0.NetAddrs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetAddrsAnnounced indicates an expected call of NetAddrsAnnounced.
+func (mr *MockFullNodeMockRecorder) NetAddrsAnnounced(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetAddrsAnnounced", reflect.TypeOf((*MockFullNode)(nil).NetAddrsAnnounced), arg0)
+}
+
 // NetAddrsListen mocks base method.
 func (m *MockFullNode) NetAddrsListen(arg0 context.Context) (peer.AddrInfo, error) {
 	m.ctrl.T.Helper()
@@ -1883,6 +2044,21 @@ func (mr *MockFullNodeMockRecorder) NetPubsubScores(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetPubsubScores", reflect.TypeOf((*MockFullNode)(nil).NetPubsubScores), arg0)
 }
 
+// NetReachability mocks base method.
+func (m *MockFullNode) NetReachability(arg0 context.Context) (types0.ReachabilityInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetReachability", arg0)
+	ret0, _ := ret[0].(types0.ReachabilityInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetReachability indicates an expected call of NetReachability.
+func (mr *MockFullNodeMockRecorder) NetReachability(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetReachability", reflect.TypeOf((*MockFullNode)(nil).NetReachability), arg0)
+}
+
 // NetVersion mocks base method.
 func (m *MockFullNode) NetVersion(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -2315,6 +2491,21 @@ func (mr *MockFullNodeMockRecorder) StateCall(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateCall", reflect.TypeOf((*MockFullNode)(nil).StateCall), arg0, arg1, arg2)
 }
 
+// StateCallWithWitness mocks base method.
+func (m *MockFullNode) StateCallWithWitness(arg0 context.Context, arg1 *types.Message, arg2 types0.TipSetKey) (*types0.CallWitness, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateCallWithWitness", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types0.CallWitness)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateCallWithWitness indicates an expected call of StateCallWithWitness.
+func (mr *MockFullNodeMockRecorder) StateCallWithWitness(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateCallWithWitness", reflect.TypeOf((*MockFullNode)(nil).StateCallWithWitness), arg0, arg1, arg2)
+}
+
 // StateChangedActors mocks base method.
 func (m *MockFullNode) StateChangedActors(arg0 context.Context, arg1, arg2 cid.Cid) (map[string]types.ActorV5, error) {
 	m.ctrl.T.Helper()
@@ -2390,6 +2581,21 @@ func (mr *MockFullNodeMockRecorder) StateDealProviderCollateralBounds(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateDealProviderCollateralBounds", reflect.TypeOf((*MockFullNode)(nil).StateDealProviderCollateralBounds), arg0, arg1, arg2, arg3)
 }
 
+// StateDealSector mocks base method.
+func (m *MockFullNode) StateDealSector(arg0 context.Context, arg1 abi.DealID, arg2 types0.TipSetKey) (*types0.DealSector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateDealSector", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types0.DealSector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateDealSector indicates an expected call of StateDealSector.
+func (mr *MockFullNodeMockRecorder) StateDealSector(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateDealSector", reflect.TypeOf((*MockFullNode)(nil).StateDealSector), arg0, arg1, arg2)
+}
+
 // StateDecodeParams mocks base method.
 func (m *MockFullNode) StateDecodeParams(arg0 context.Context, arg1 address.Address, arg2 abi.MethodNum, arg3 []byte, arg4 types0.TipSetKey) (interface{}, error) {
 	m.ctrl.T.Helper()
@@ -2660,6 +2866,21 @@ func (mr *MockFullNodeMockRecorder) StateListActors(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateListActors", reflect.TypeOf((*MockFullNode)(nil).StateListActors), arg0, arg1)
 }
 
+// StateListActorsByCode mocks base method.
+func (m *MockFullNode) StateListActorsByCode(arg0 context.Context, arg1 cid.Cid, arg2, arg3 int, arg4 types0.TipSetKey) ([]address.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateListActorsByCode", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]address.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateListActorsByCode indicates an expected call of StateListActorsByCode.
+func (mr *MockFullNodeMockRecorder) StateListActorsByCode(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateListActorsByCode", reflect.TypeOf((*MockFullNode)(nil).StateListActorsByCode), arg0, arg1, arg2, arg3, arg4)
+}
+
 // StateListMessages mocks base method.
 func (m *MockFullNode) StateListMessages(arg0 context.Context, arg1 *types0.MessageMatch, arg2 types0.TipSetKey, arg3 abi.ChainEpoch) ([]cid.Cid, error) {
 	m.ctrl.T.Helper()
@@ -2735,6 +2956,21 @@ func (mr *MockFullNodeMockRecorder) StateMarketBalance(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMarketBalance", reflect.TypeOf((*MockFullNode)(nil).StateMarketBalance), arg0, arg1, arg2)
 }
 
+// StateMarketDealStateChanges mocks base method.
+func (m *MockFullNode) StateMarketDealStateChanges(arg0 context.Context, arg1, arg2 types0.TipSetKey) ([]types0.MarketDealStateChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMarketDealStateChanges", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types0.MarketDealStateChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMarketDealStateChanges indicates an expected call of StateMarketDealStateChanges.
+func (mr *MockFullNodeMockRecorder) StateMarketDealStateChanges(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMarketDealStateChanges", reflect.TypeOf((*MockFullNode)(nil).StateMarketDealStateChanges), arg0, arg1, arg2)
+}
+
 // StateMarketDeals mocks base method.
 func (m *MockFullNode) StateMarketDeals(arg0 context.Context, arg1 types0.TipSetKey) (map[string]*types0.MarketDeal, error) {
 	m.ctrl.T.Helper()
@@ -2840,6 +3076,21 @@ func (mr *MockFullNodeMockRecorder) StateMinerDeadlines(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMinerDeadlines", reflect.TypeOf((*MockFullNode)(nil).StateMinerDeadlines), arg0, arg1, arg2)
 }
 
+// StateMinerFaultFee mocks base method.
+func (m *MockFullNode) StateMinerFaultFee(arg0 context.Context, arg1 address.Address, arg2 []abi.SectorNumber, arg3 types0.TipSetKey) (big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMinerFaultFee", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMinerFaultFee indicates an expected call of StateMinerFaultFee.
+func (mr *MockFullNodeMockRecorder) StateMinerFaultFee(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMinerFaultFee", reflect.TypeOf((*MockFullNode)(nil).StateMinerFaultFee), arg0, arg1, arg2, arg3)
+}
+
 // StateMinerFaults mocks base method.
 func (m *MockFullNode) StateMinerFaults(arg0 context.Context, arg1 address.Address, arg2 types0.TipSetKey) (bitfield.BitField, error) {
 	m.ctrl.T.Helper()
@@ -3020,6 +3271,21 @@ func (mr *MockFullNodeMockRecorder) StateMinerSectors(arg0, arg1, arg2, arg3 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMinerSectors", reflect.TypeOf((*MockFullNode)(nil).StateMinerSectors), arg0, arg1, arg2, arg3)
 }
 
+// StateMinerTerminationFee mocks base method.
+func (m *MockFullNode) StateMinerTerminationFee(arg0 context.Context, arg1 address.Address, arg2 []abi.SectorNumber, arg3 types0.TipSetKey) (big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMinerTerminationFee", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMinerTerminationFee indicates an expected call of StateMinerTerminationFee.
+func (mr *MockFullNodeMockRecorder) StateMinerTerminationFee(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMinerTerminationFee", reflect.TypeOf((*MockFullNode)(nil).StateMinerTerminationFee), arg0, arg1, arg2, arg3)
+}
+
 // StateMinerWorkerAddress mocks base method.
 func (m *MockFullNode) StateMinerWorkerAddress(arg0 context.Context, arg1 address.Address, arg2 types0.TipSetKey) (address.Address, error) {
 	m.ctrl.T.Helper()
@@ -3170,6 +3436,21 @@ func (mr *MockFullNodeMockRecorder) StateSectorPreCommitInfo(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateSectorPreCommitInfo", reflect.TypeOf((*MockFullNode)(nil).StateSectorPreCommitInfo), arg0, arg1, arg2, arg3)
 }
 
+// StateSectorStatus mocks base method.
+func (m *MockFullNode) StateSectorStatus(arg0 context.Context, arg1 address.Address, arg2 abi.SectorNumber, arg3 types0.TipSetKey) (*types0.SectorStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateSectorStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types0.SectorStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateSectorStatus indicates an expected call of StateSectorStatus.
+func (mr *MockFullNodeMockRecorder) StateSectorStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateSectorStatus", reflect.TypeOf((*MockFullNode)(nil).StateSectorStatus), arg0, arg1, arg2, arg3)
+}
+
 // StateVMCirculatingSupplyInternal mocks base method.
 func (m *MockFullNode) StateVMCirculatingSupplyInternal(arg0 context.Context, arg1 types0.TipSetKey) (types0.CirculatingSupply, error) {
 	m.ctrl.T.Helper()
@@ -3260,6 +3541,21 @@ func (mr *MockFullNodeMockRecorder) SubscribeActorEventsRaw(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeActorEventsRaw", reflect.TypeOf((*MockFullNode)(nil).SubscribeActorEventsRaw), arg0, arg1)
 }
 
+// SyncFailures mocks base method.
+func (m *MockFullNode) SyncFailures(arg0 context.Context) ([]types0.SyncFailure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncFailures", arg0)
+	ret0, _ := ret[0].([]types0.SyncFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncFailures indicates an expected call of SyncFailures.
+func (mr *MockFullNodeMockRecorder) SyncFailures(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncFailures", reflect.TypeOf((*MockFullNode)(nil).SyncFailures), arg0)
+}
+
 // SyncIncomingBlocks mocks base method.
 func (m *MockFullNode) SyncIncomingBlocks(arg0 context.Context) (<-chan *types0.BlockHeader, error) {
 	m.ctrl.T.Helper()
@@ -3275,6 +3571,21 @@ func (mr *MockFullNodeMockRecorder) SyncIncomingBlocks(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncIncomingBlocks", reflect.TypeOf((*MockFullNode)(nil).SyncIncomingBlocks), arg0)
 }
 
+// SyncListPeerHeads mocks base method.
+func (m *MockFullNode) SyncListPeerHeads(arg0 context.Context) ([]types0.PeerHead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncListPeerHeads", arg0)
+	ret0, _ := ret[0].([]types0.PeerHead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncListPeerHeads indicates an expected call of SyncListPeerHeads.
+func (mr *MockFullNodeMockRecorder) SyncListPeerHeads(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncListPeerHeads", reflect.TypeOf((*MockFullNode)(nil).SyncListPeerHeads), arg0)
+}
+
 // SyncState mocks base method.
 func (m *MockFullNode) SyncState(arg0 context.Context) (*types0.SyncState, error) {
 	m.ctrl.T.Helper()
@@ -3390,6 +3701,21 @@ func (mr *MockFullNodeMockRecorder) WalletBalance(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletBalance", reflect.TypeOf((*MockFullNode)(nil).WalletBalance), arg0, arg1)
 }
 
+// WalletBalanceAt mocks base method.
+func (m *MockFullNode) WalletBalanceAt(arg0 context.Context, arg1 address.Address, arg2 types0.TipSetKey, arg3 bool) (big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WalletBalanceAt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WalletBalanceAt indicates an expected call of WalletBalanceAt.
+func (mr *MockFullNodeMockRecorder) WalletBalanceAt(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WalletBalanceAt", reflect.TypeOf((*MockFullNode)(nil).WalletBalanceAt), arg0, arg1, arg2, arg3)
+}
+
 // WalletDefaultAddress mocks base method.
 func (m *MockFullNode) WalletDefaultAddress(arg0 context.Context) (address.Address, error) {
 	m.ctrl.T.Helper()
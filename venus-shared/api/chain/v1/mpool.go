@@ -33,6 +33,9 @@ type IMessagePool interface {
 	GasEstimateFeeCap(ctx context.Context, msg *types.Message, maxqueueblks int64, tsk types.TipSetKey) (big.Int, error)                                               //perm:read
 	GasEstimateGasPremium(ctx context.Context, nblocksincl uint64, sender address.Address, gaslimit int64, tsk types.TipSetKey) (big.Int, error)                       //perm:read
 	GasEstimateGasLimit(ctx context.Context, msgIn *types.Message, tsk types.TipSetKey) (int64, error)                                                                 //perm:read
+	// MpoolGasMarket returns the distribution of the gas premiums in the pool and in the last tipsets,
+	// with the trend of the base fee, for wallets to give fee guidance. tipsets defaults to 10 and is capped at 40
+	MpoolGasMarket(ctx context.Context, tipsets int) (*types.GasMarket, error) //perm:read
 	// MpoolCheckMessages performs logical checks on a batch of messages
 	MpoolCheckMessages(ctx context.Context, protos []*types.MessagePrototype) ([][]types.MessageCheckStatus, error) //perm:read
 	// MpoolCheckPendingMessages performs logical checks for all pending messages from a given address
@@ -24,10 +24,14 @@ type INetwork interface {
 	NetAgentVersion(ctx context.Context, p peer.ID) (string, error)                         //perm:read
 	NetPing(ctx context.Context, p peer.ID) (time.Duration, error)                          //perm:read
 	NetAddrsListen(ctx context.Context) (peer.AddrInfo, error)                              //perm:read
-	NetDisconnect(ctx context.Context, p peer.ID) error                                     //perm:admin
-	NetAutoNatStatus(context.Context) (types.NatInfo, error)                                //perm:read
-	NetPubsubScores(context.Context) ([]types.PubsubScore, error)                           //perm:read
-	ID(ctx context.Context) (peer.ID, error)                                                //perm:read
+	// NetAddrsAnnounced returns the addresses the node listens on and the ones it advertises to peers
+	NetAddrsAnnounced(ctx context.Context) (types.NetAddrs, error) //perm:read
+	NetDisconnect(ctx context.Context, p peer.ID) error            //perm:admin
+	NetAutoNatStatus(context.Context) (types.NatInfo, error)       //perm:read
+	// NetReachability reports the nat status, the addresses the node advertises and the result of the last probe of each static relay
+	NetReachability(context.Context) (types.ReachabilityInfo, error) //perm:read
+	NetPubsubScores(context.Context) ([]types.PubsubScore, error)    //perm:read
+	ID(ctx context.Context) (peer.ID, error)                         //perm:read
 
 	// NetBandwidthStats returns statistics about the nodes total bandwidth
 	// usage and current rate across all peers and protocols.
@@ -83,6 +83,7 @@ type IMinerStateStruct struct {
 		StateCirculatingSupply             func(ctx context.Context, tsk types.TipSetKey) (abi.TokenAmount, error)                                                                        `perm:"read"`
 		StateComputeDataCID                func(ctx context.Context, maddr address.Address, sectorType abi.RegisteredSealProof, deals []abi.DealID, tsk types.TipSetKey) (cid.Cid, error) `perm:"read"`
 		StateDealProviderCollateralBounds  func(ctx context.Context, size abi.PaddedPieceSize, verified bool, tsk types.TipSetKey) (types.DealCollateralBounds, error)                    `perm:"read"`
+		StateDealSector                    func(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.DealSector, error)                                                   `perm:"read"`
 		StateDecodeParams                  func(ctx context.Context, toAddr address.Address, method abi.MethodNum, params []byte, tsk types.TipSetKey) (interface{}, error)               `perm:"read"`
 		StateEncodeParams                  func(ctx context.Context, toActCode cid.Cid, method abi.MethodNum, params json.RawMessage) ([]byte, error)                                     `perm:"read"`
 		StateGetAllAllocations             func(ctx context.Context, tsk types.TipSetKey) (map[types.AllocationId]types.Allocation, error)                                                `perm:"read"`
@@ -94,17 +95,20 @@ type IMinerStateStruct struct {
 		StateGetClaim                      func(ctx context.Context, providerAddr address.Address, claimID types.ClaimId, tsk types.TipSetKey) (*types.Claim, error)                      `perm:"read"`
 		StateGetClaims                     func(ctx context.Context, providerAddr address.Address, tsk types.TipSetKey) (map[types.ClaimId]types.Claim, error)                            `perm:"read"`
 		StateListActors                    func(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                                                                      `perm:"read"`
+		StateListActorsByCode              func(ctx context.Context, code cid.Cid, offset, limit int, tsk types.TipSetKey) ([]address.Address, error)                                     `perm:"read"`
 		StateListMessages                  func(ctx context.Context, match *types.MessageMatch, tsk types.TipSetKey, toht abi.ChainEpoch) ([]cid.Cid, error)                              `perm:"read"`
 		StateListMiners                    func(ctx context.Context, tsk types.TipSetKey) ([]address.Address, error)                                                                      `perm:"read"`
 		StateLookupID                      func(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error)                                                  `perm:"read"`
 		StateLookupRobustAddress           func(context.Context, address.Address, types.TipSetKey) (address.Address, error)                                                               `perm:"read"`
 		StateMarketBalance                 func(ctx context.Context, addr address.Address, tsk types.TipSetKey) (types.MarketBalance, error)                                              `perm:"read"`
+		StateMarketDealStateChanges        func(ctx context.Context, from, to types.TipSetKey) ([]types.MarketDealStateChange, error)                                                     `perm:"read"`
 		StateMarketDeals                   func(ctx context.Context, tsk types.TipSetKey) (map[string]*types.MarketDeal, error)                                                           `perm:"read"`
 		StateMarketStorageDeal             func(ctx context.Context, dealID abi.DealID, tsk types.TipSetKey) (*types.MarketDeal, error)                                                   `perm:"read"`
 		StateMinerActiveSectors            func(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]*types.SectorOnChainInfo, error)                                      `perm:"read"`
 		StateMinerAllocated                func(context.Context, address.Address, types.TipSetKey) (*bitfield.BitField, error)                                                            `perm:"read"`
 		StateMinerAvailableBalance         func(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (big.Int, error)                                                         `perm:"read"`
 		StateMinerDeadlines                func(ctx context.Context, maddr address.Address, tsk types.TipSetKey) ([]types.Deadline, error)                                                `perm:"read"`
+		StateMinerFaultFee                 func(ctx context.Context, maddr address.Address, sectors []abi.SectorNumber, tsk types.TipSetKey) (big.Int, error)                             `perm:"read"`
 		StateMinerFaults                   func(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (bitfield.BitField, error)                                               `perm:"read"`
 		StateMinerInfo                     func(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (types.MinerInfo, error)                                                 `perm:"read"`
 		StateMinerInitialPledgeCollateral  func(ctx context.Context, maddr address.Address, pci types.SectorPreCommitInfo, tsk types.TipSetKey) (big.Int, error)                          `perm:"read"`
@@ -117,12 +121,14 @@ type IMinerStateStruct struct {
 		StateMinerSectorCount              func(ctx context.Context, addr address.Address, tsk types.TipSetKey) (types.MinerSectors, error)                                               `perm:"read"`
 		StateMinerSectorSize               func(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (abi.SectorSize, error)                                                  `perm:"read"`
 		StateMinerSectors                  func(ctx context.Context, maddr address.Address, sectorNos *bitfield.BitField, tsk types.TipSetKey) ([]*types.SectorOnChainInfo, error)        `perm:"read"`
+		StateMinerTerminationFee           func(ctx context.Context, maddr address.Address, sectors []abi.SectorNumber, tsk types.TipSetKey) (big.Int, error)                             `perm:"read"`
 		StateMinerWorkerAddress            func(ctx context.Context, maddr address.Address, tsk types.TipSetKey) (address.Address, error)                                                 `perm:"read"`
 		StateReadState                     func(ctx context.Context, actor address.Address, tsk types.TipSetKey) (*types.ActorState, error)                                               `perm:"read"`
 		StateSectorExpiration              func(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorExpiration, error)         `perm:"read"`
 		StateSectorGetInfo                 func(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorOnChainInfo, error)                    `perm:"read"`
 		StateSectorPartition               func(ctx context.Context, maddr address.Address, sectorNumber abi.SectorNumber, tsk types.TipSetKey) (*lminer.SectorLocation, error)           `perm:"read"`
 		StateSectorPreCommitInfo           func(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorPreCommitOnChainInfo, error)           `perm:"read"`
+		StateSectorStatus                  func(ctx context.Context, maddr address.Address, n abi.SectorNumber, tsk types.TipSetKey) (*types.SectorStatus, error)                         `perm:"read"`
 		StateVMCirculatingSupplyInternal   func(ctx context.Context, tsk types.TipSetKey) (types.CirculatingSupply, error)                                                                `perm:"read"`
 		StateVerifiedClientStatus          func(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*abi.StoragePower, error)                                                `perm:"read"`
 	}
@@ -143,6 +149,9 @@ func (s *IMinerStateStruct) StateComputeDataCID(p0 context.Context, p1 address.A
 func (s *IMinerStateStruct) StateDealProviderCollateralBounds(p0 context.Context, p1 abi.PaddedPieceSize, p2 bool, p3 types.TipSetKey) (types.DealCollateralBounds, error) {
 	return s.Internal.StateDealProviderCollateralBounds(p0, p1, p2, p3)
 }
+func (s *IMinerStateStruct) StateDealSector(p0 context.Context, p1 abi.DealID, p2 types.TipSetKey) (*types.DealSector, error) {
+	return s.Internal.StateDealSector(p0, p1, p2)
+}
 func (s *IMinerStateStruct) StateDecodeParams(p0 context.Context, p1 address.Address, p2 abi.MethodNum, p3 []byte, p4 types.TipSetKey) (interface{}, error) {
 	return s.Internal.StateDecodeParams(p0, p1, p2, p3, p4)
 }
@@ -176,6 +185,9 @@ func (s *IMinerStateStruct) StateGetClaims(p0 context.Context, p1 address.Addres
 func (s *IMinerStateStruct) StateListActors(p0 context.Context, p1 types.TipSetKey) ([]address.Address, error) {
 	return s.Internal.StateListActors(p0, p1)
 }
+func (s *IMinerStateStruct) StateListActorsByCode(p0 context.Context, p1 cid.Cid, p2, p3 int, p4 types.TipSetKey) ([]address.Address, error) {
+	return s.Internal.StateListActorsByCode(p0, p1, p2, p3, p4)
+}
 func (s *IMinerStateStruct) StateListMessages(p0 context.Context, p1 *types.MessageMatch, p2 types.TipSetKey, p3 abi.ChainEpoch) ([]cid.Cid, error) {
 	return s.Internal.StateListMessages(p0, p1, p2, p3)
 }
@@ -191,6 +203,9 @@ func (s *IMinerStateStruct) StateLookupRobustAddress(p0 context.Context, p1 addr
 func (s *IMinerStateStruct) StateMarketBalance(p0 context.Context, p1 address.Address, p2 types.TipSetKey) (types.MarketBalance, error) {
 	return s.Internal.StateMarketBalance(p0, p1, p2)
 }
+func (s *IMinerStateStruct) StateMarketDealStateChanges(p0 context.Context, p1, p2 types.TipSetKey) ([]types.MarketDealStateChange, error) {
+	return s.Internal.StateMarketDealStateChanges(p0, p1, p2)
+}
 func (s *IMinerStateStruct) StateMarketDeals(p0 context.Context, p1 types.TipSetKey) (map[string]*types.MarketDeal, error) {
 	return s.Internal.StateMarketDeals(p0, p1)
 }
@@ -209,6 +224,9 @@ func (s *IMinerStateStruct) StateMinerAvailableBalance(p0 context.Context, p1 ad
 func (s *IMinerStateStruct) StateMinerDeadlines(p0 context.Context, p1 address.Address, p2 types.TipSetKey) ([]types.Deadline, error) {
 	return s.Internal.StateMinerDeadlines(p0, p1, p2)
 }
+func (s *IMinerStateStruct) StateMinerFaultFee(p0 context.Context, p1 address.Address, p2 []abi.SectorNumber, p3 types.TipSetKey) (big.Int, error) {
+	return s.Internal.StateMinerFaultFee(p0, p1, p2, p3)
+}
 func (s *IMinerStateStruct) StateMinerFaults(p0 context.Context, p1 address.Address, p2 types.TipSetKey) (bitfield.BitField, error) {
 	return s.Internal.StateMinerFaults(p0, p1, p2)
 }
@@ -245,6 +263,9 @@ func (s *IMinerStateStruct) StateMinerSectorSize(p0 context.Context, p1 address.
 func (s *IMinerStateStruct) StateMinerSectors(p0 context.Context, p1 address.Address, p2 *bitfield.BitField, p3 types.TipSetKey) ([]*types.SectorOnChainInfo, error) {
 	return s.Internal.StateMinerSectors(p0, p1, p2, p3)
 }
+func (s *IMinerStateStruct) StateMinerTerminationFee(p0 context.Context, p1 address.Address, p2 []abi.SectorNumber, p3 types.TipSetKey) (big.Int, error) {
+	return s.Internal.StateMinerTerminationFee(p0, p1, p2, p3)
+}
 func (s *IMinerStateStruct) StateMinerWorkerAddress(p0 context.Context, p1 address.Address, p2 types.TipSetKey) (address.Address, error) {
 	return s.Internal.StateMinerWorkerAddress(p0, p1, p2)
 }
@@ -263,6 +284,9 @@ func (s *IMinerStateStruct) StateSectorPartition(p0 context.Context, p1 address.
 func (s *IMinerStateStruct) StateSectorPreCommitInfo(p0 context.Context, p1 address.Address, p2 abi.SectorNumber, p3 types.TipSetKey) (*types.SectorPreCommitOnChainInfo, error) {
 	return s.Internal.StateSectorPreCommitInfo(p0, p1, p2, p3)
 }
+func (s *IMinerStateStruct) StateSectorStatus(p0 context.Context, p1 address.Address, p2 abi.SectorNumber, p3 types.TipSetKey) (*types.SectorStatus, error) {
+	return s.Internal.StateSectorStatus(p0, p1, p2, p3)
+}
 func (s *IMinerStateStruct) StateVMCirculatingSupplyInternal(p0 context.Context, p1 types.TipSetKey) (types.CirculatingSupply, error) {
 	return s.Internal.StateVMCirculatingSupplyInternal(p0, p1)
 }
@@ -272,10 +296,13 @@ func (s *IMinerStateStruct) StateVerifiedClientStatus(p0 context.Context, p1 add
 
 type IChainInfoStruct struct {
 	Internal struct {
+		BeaconGetStatus                     func(ctx context.Context) ([]types.DrandStatus, error)                                                                                                       `perm:"read"`
 		BlockTime                           func(ctx context.Context) time.Duration                                                                                                                      `perm:"read"`
 		ChainExport                         func(context.Context, abi.ChainEpoch, bool, types.TipSetKey) (<-chan []byte, error)                                                                          `perm:"read"`
+		ChainFsck                           func(ctx context.Context, from, to abi.ChainEpoch, repair bool) ([]types.ChainFsckIssue, error)                                                              `perm:"admin"`
 		ChainGetBlock                       func(ctx context.Context, id cid.Cid) (*types.BlockHeader, error)                                                                                            `perm:"read"`
 		ChainGetBlockMessages               func(ctx context.Context, bid cid.Cid) (*types.BlockMessages, error)                                                                                         `perm:"read"`
+		ChainGetDecodedMessagesInTipset     func(ctx context.Context, key types.TipSetKey) ([]types.DecodedMessage, error)                                                                               `perm:"read"`
 		ChainGetEvents                      func(context.Context, cid.Cid) ([]types.Event, error)                                                                                                        `perm:"read"`
 		ChainGetGenesis                     func(context.Context) (*types.TipSet, error)                                                                                                                 `perm:"read"`
 		ChainGetMessage                     func(ctx context.Context, msgID cid.Cid) (*types.Message, error)                                                                                             `perm:"read"`
@@ -291,6 +318,7 @@ type IChainInfoStruct struct {
 		ChainList                           func(ctx context.Context, tsKey types.TipSetKey, count int) ([]types.TipSetKey, error)                                                                       `perm:"read"`
 		ChainNotify                         func(ctx context.Context) (<-chan []*types.HeadChange, error)                                                                                                `perm:"read"`
 		ChainSetHead                        func(ctx context.Context, key types.TipSetKey) error                                                                                                         `perm:"admin"`
+		ChainSnapshotStatus                 func(ctx context.Context) (*types.SnapshotStatus, error)                                                                                                     `perm:"read"`
 		GetActor                            func(ctx context.Context, addr address.Address) (*types.Actor, error)                                                                                        `perm:"read"`
 		GetEntry                            func(ctx context.Context, height abi.ChainEpoch, round uint64) (*types.BeaconEntry, error)                                                                   `perm:"read"`
 		GetFullBlock                        func(ctx context.Context, id cid.Cid) (*types.FullBlock, error)                                                                                              `perm:"read"`
@@ -300,6 +328,7 @@ type IChainInfoStruct struct {
 		StateActorCodeCIDs                  func(context.Context, network.Version) (map[string]cid.Cid, error)                                                                                           `perm:"read"`
 		StateActorManifestCID               func(context.Context, network.Version) (cid.Cid, error)                                                                                                      `perm:"read"`
 		StateCall                           func(ctx context.Context, msg *types.Message, tsk types.TipSetKey) (*types.InvocResult, error)                                                               `perm:"read"`
+		StateCallWithWitness                func(ctx context.Context, msg *types.Message, tsk types.TipSetKey) (*types.CallWitness, error)                                                               `perm:"read"`
 		StateCompute                        func(context.Context, abi.ChainEpoch, []*types.Message, types.TipSetKey) (*types.ComputeStateOutput, error)                                                  `perm:"read"`
 		StateGetBeaconEntry                 func(ctx context.Context, epoch abi.ChainEpoch) (*types.BeaconEntry, error)                                                                                  `perm:"read"`
 		StateGetNetworkParams               func(ctx context.Context) (*types.NetworkParams, error)                                                                                                      `perm:"read"`
@@ -318,18 +347,27 @@ type IChainInfoStruct struct {
 	}
 }
 
+func (s *IChainInfoStruct) BeaconGetStatus(p0 context.Context) ([]types.DrandStatus, error) {
+	return s.Internal.BeaconGetStatus(p0)
+}
 func (s *IChainInfoStruct) BlockTime(p0 context.Context) time.Duration {
 	return s.Internal.BlockTime(p0)
 }
 func (s *IChainInfoStruct) ChainExport(p0 context.Context, p1 abi.ChainEpoch, p2 bool, p3 types.TipSetKey) (<-chan []byte, error) {
 	return s.Internal.ChainExport(p0, p1, p2, p3)
 }
+func (s *IChainInfoStruct) ChainFsck(p0 context.Context, p1, p2 abi.ChainEpoch, p3 bool) ([]types.ChainFsckIssue, error) {
+	return s.Internal.ChainFsck(p0, p1, p2, p3)
+}
 func (s *IChainInfoStruct) ChainGetBlock(p0 context.Context, p1 cid.Cid) (*types.BlockHeader, error) {
 	return s.Internal.ChainGetBlock(p0, p1)
 }
 func (s *IChainInfoStruct) ChainGetBlockMessages(p0 context.Context, p1 cid.Cid) (*types.BlockMessages, error) {
 	return s.Internal.ChainGetBlockMessages(p0, p1)
 }
+func (s *IChainInfoStruct) ChainGetDecodedMessagesInTipset(p0 context.Context, p1 types.TipSetKey) ([]types.DecodedMessage, error) {
+	return s.Internal.ChainGetDecodedMessagesInTipset(p0, p1)
+}
 func (s *IChainInfoStruct) ChainGetEvents(p0 context.Context, p1 cid.Cid) ([]types.Event, error) {
 	return s.Internal.ChainGetEvents(p0, p1)
 }
@@ -375,6 +413,9 @@ func (s *IChainInfoStruct) ChainNotify(p0 context.Context) (<-chan []*types.Head
 func (s *IChainInfoStruct) ChainSetHead(p0 context.Context, p1 types.TipSetKey) error {
 	return s.Internal.ChainSetHead(p0, p1)
 }
+func (s *IChainInfoStruct) ChainSnapshotStatus(p0 context.Context) (*types.SnapshotStatus, error) {
+	return s.Internal.ChainSnapshotStatus(p0)
+}
 func (s *IChainInfoStruct) GetActor(p0 context.Context, p1 address.Address) (*types.Actor, error) {
 	return s.Internal.GetActor(p0, p1)
 }
@@ -402,6 +443,9 @@ func (s *IChainInfoStruct) StateActorManifestCID(p0 context.Context, p1 network.
 func (s *IChainInfoStruct) StateCall(p0 context.Context, p1 *types.Message, p2 types.TipSetKey) (*types.InvocResult, error) {
 	return s.Internal.StateCall(p0, p1, p2)
 }
+func (s *IChainInfoStruct) StateCallWithWitness(p0 context.Context, p1 *types.Message, p2 types.TipSetKey) (*types.CallWitness, error) {
+	return s.Internal.StateCallWithWitness(p0, p1, p2)
+}
 func (s *IChainInfoStruct) StateCompute(p0 context.Context, p1 abi.ChainEpoch, p2 []*types.Message, p3 types.TipSetKey) (*types.ComputeStateOutput, error) {
 	return s.Internal.StateCompute(p0, p1, p2, p3)
 }
@@ -469,6 +513,7 @@ type IMiningStruct struct {
 	Internal struct {
 		MinerCreateBlock func(ctx context.Context, bt *types.BlockTemplate) (*types.BlockMsg, error)                                                `perm:"write"`
 		MinerGetBaseInfo func(ctx context.Context, maddr address.Address, round abi.ChainEpoch, tsk types.TipSetKey) (*types.MiningBaseInfo, error) `perm:"read"`
+		MinerGetStats    func(ctx context.Context) ([]types.MinerStats, error)                                                                      `perm:"read"`
 	}
 }
 
@@ -478,6 +523,9 @@ func (s *IMiningStruct) MinerCreateBlock(p0 context.Context, p1 *types.BlockTemp
 func (s *IMiningStruct) MinerGetBaseInfo(p0 context.Context, p1 address.Address, p2 abi.ChainEpoch, p3 types.TipSetKey) (*types.MiningBaseInfo, error) {
 	return s.Internal.MinerGetBaseInfo(p0, p1, p2, p3)
 }
+func (s *IMiningStruct) MinerGetStats(p0 context.Context) ([]types.MinerStats, error) {
+	return s.Internal.MinerGetStats(p0)
+}
 
 type IMessagePoolStruct struct {
 	Internal struct {
@@ -494,6 +542,7 @@ type IMessagePoolStruct struct {
 		MpoolCheckReplaceMessages  func(ctx context.Context, msg []*types.Message) ([][]types.MessageCheckStatus, error)                                                        `perm:"read"`
 		MpoolClear                 func(ctx context.Context, local bool) error                                                                                                  `perm:"write"`
 		MpoolDeleteByAdress        func(ctx context.Context, addr address.Address) error                                                                                        `perm:"admin"`
+		MpoolGasMarket             func(ctx context.Context, tipsets int) (*types.GasMarket, error)                                                                             `perm:"read"`
 		MpoolGetConfig             func(context.Context) (*types.MpoolConfig, error)                                                                                            `perm:"read"`
 		MpoolGetNonce              func(ctx context.Context, addr address.Address) (uint64, error)                                                                              `perm:"read"`
 		MpoolPending               func(ctx context.Context, tsk types.TipSetKey) ([]*types.SignedMessage, error)                                                               `perm:"read"`
@@ -548,6 +597,9 @@ func (s *IMessagePoolStruct) MpoolClear(p0 context.Context, p1 bool) error {
 func (s *IMessagePoolStruct) MpoolDeleteByAdress(p0 context.Context, p1 address.Address) error {
 	return s.Internal.MpoolDeleteByAdress(p0, p1)
 }
+func (s *IMessagePoolStruct) MpoolGasMarket(p0 context.Context, p1 int) (*types.GasMarket, error) {
+	return s.Internal.MpoolGasMarket(p0, p1)
+}
 func (s *IMessagePoolStruct) MpoolGetConfig(p0 context.Context) (*types.MpoolConfig, error) {
 	return s.Internal.MpoolGetConfig(p0)
 }
@@ -588,6 +640,7 @@ func (s *IMessagePoolStruct) MpoolSub(p0 context.Context) (<-chan types.MpoolUpd
 type INetworkStruct struct {
 	Internal struct {
 		ID                          func(ctx context.Context) (peer.ID, error)                             `perm:"read"`
+		NetAddrsAnnounced           func(ctx context.Context) (types.NetAddrs, error)                      `perm:"read"`
 		NetAddrsListen              func(ctx context.Context) (peer.AddrInfo, error)                       `perm:"read"`
 		NetAgentVersion             func(ctx context.Context, p peer.ID) (string, error)                   `perm:"read"`
 		NetAutoNatStatus            func(context.Context) (types.NatInfo, error)                           `perm:"read"`
@@ -607,10 +660,14 @@ type INetworkStruct struct {
 		NetProtectList              func(ctx context.Context) ([]peer.ID, error)                           `perm:"read"`
 		NetProtectRemove            func(ctx context.Context, acl []peer.ID) error                         `perm:"admin"`
 		NetPubsubScores             func(context.Context) ([]types.PubsubScore, error)                     `perm:"read"`
+		NetReachability             func(context.Context) (types.ReachabilityInfo, error)                  `perm:"read"`
 	}
 }
 
 func (s *INetworkStruct) ID(p0 context.Context) (peer.ID, error) { return s.Internal.ID(p0) }
+func (s *INetworkStruct) NetAddrsAnnounced(p0 context.Context) (types.NetAddrs, error) {
+	return s.Internal.NetAddrsAnnounced(p0)
+}
 func (s *INetworkStruct) NetAddrsListen(p0 context.Context) (peer.AddrInfo, error) {
 	return s.Internal.NetAddrsListen(p0)
 }
@@ -668,6 +725,9 @@ func (s *INetworkStruct) NetProtectRemove(p0 context.Context, p1 []peer.ID) erro
 func (s *INetworkStruct) NetPubsubScores(p0 context.Context) ([]types.PubsubScore, error) {
 	return s.Internal.NetPubsubScores(p0)
 }
+func (s *INetworkStruct) NetReachability(p0 context.Context) (types.ReachabilityInfo, error) {
+	return s.Internal.NetReachability(p0)
+}
 
 type IPaychanStruct struct {
 	Internal struct {
@@ -749,7 +809,9 @@ type ISyncerStruct struct {
 		ChainTipSetWeight        func(ctx context.Context, tsk types.TipSetKey) (big.Int, error) `perm:"read"`
 		Concurrent               func(ctx context.Context) int64                                 `perm:"read"`
 		SetConcurrent            func(ctx context.Context, concurrent int64) error               `perm:"admin"`
+		SyncFailures             func(ctx context.Context) ([]types.SyncFailure, error)          `perm:"read"`
 		SyncIncomingBlocks       func(ctx context.Context) (<-chan *types.BlockHeader, error)    `perm:"read"`
+		SyncListPeerHeads        func(ctx context.Context) ([]types.PeerHead, error)             `perm:"read"`
 		SyncState                func(ctx context.Context) (*types.SyncState, error)             `perm:"read"`
 		SyncSubmitBlock          func(ctx context.Context, blk *types.BlockMsg) error            `perm:"write"`
 		SyncerTracker            func(ctx context.Context) *types.TargetTracker                  `perm:"read"`
@@ -766,9 +828,15 @@ func (s *ISyncerStruct) Concurrent(p0 context.Context) int64 { return s.Internal
 func (s *ISyncerStruct) SetConcurrent(p0 context.Context, p1 int64) error {
 	return s.Internal.SetConcurrent(p0, p1)
 }
+func (s *ISyncerStruct) SyncFailures(p0 context.Context) ([]types.SyncFailure, error) {
+	return s.Internal.SyncFailures(p0)
+}
 func (s *ISyncerStruct) SyncIncomingBlocks(p0 context.Context) (<-chan *types.BlockHeader, error) {
 	return s.Internal.SyncIncomingBlocks(p0)
 }
+func (s *ISyncerStruct) SyncListPeerHeads(p0 context.Context) ([]types.PeerHead, error) {
+	return s.Internal.SyncListPeerHeads(p0)
+}
 func (s *ISyncerStruct) SyncState(p0 context.Context) (*types.SyncState, error) {
 	return s.Internal.SyncState(p0)
 }
@@ -781,22 +849,23 @@ func (s *ISyncerStruct) SyncerTracker(p0 context.Context) *types.TargetTracker {
 
 type IWalletStruct struct {
 	Internal struct {
-		HasPassword          func(ctx context.Context) bool                                                                          `perm:"admin"`
-		LockWallet           func(ctx context.Context) error                                                                         `perm:"admin"`
-		SetPassword          func(ctx context.Context, password []byte) error                                                        `perm:"admin"`
-		UnLockWallet         func(ctx context.Context, password []byte) error                                                        `perm:"admin"`
-		WalletAddresses      func(ctx context.Context) []address.Address                                                             `perm:"admin"`
-		WalletBalance        func(ctx context.Context, addr address.Address) (abi.TokenAmount, error)                                `perm:"read"`
-		WalletDefaultAddress func(ctx context.Context) (address.Address, error)                                                      `perm:"write"`
-		WalletDelete         func(ctx context.Context, addr address.Address) error                                                   `perm:"admin"`
-		WalletExport         func(ctx context.Context, addr address.Address, password string) (*types.KeyInfo, error)                `perm:"admin"`
-		WalletHas            func(ctx context.Context, addr address.Address) (bool, error)                                           `perm:"write"`
-		WalletImport         func(ctx context.Context, key *types.KeyInfo) (address.Address, error)                                  `perm:"admin"`
-		WalletNewAddress     func(ctx context.Context, protocol address.Protocol) (address.Address, error)                           `perm:"write"`
-		WalletSetDefault     func(ctx context.Context, addr address.Address) error                                                   `perm:"write"`
-		WalletSign           func(ctx context.Context, k address.Address, msg []byte, meta types.MsgMeta) (*crypto.Signature, error) `perm:"sign"`
-		WalletSignMessage    func(ctx context.Context, k address.Address, msg *types.Message) (*types.SignedMessage, error)          `perm:"sign"`
-		WalletState          func(ctx context.Context) int                                                                           `perm:"admin"`
+		HasPassword          func(ctx context.Context) bool                                                                                     `perm:"admin"`
+		LockWallet           func(ctx context.Context) error                                                                                    `perm:"admin"`
+		SetPassword          func(ctx context.Context, password []byte) error                                                                   `perm:"admin"`
+		UnLockWallet         func(ctx context.Context, password []byte) error                                                                   `perm:"admin"`
+		WalletAddresses      func(ctx context.Context) []address.Address                                                                        `perm:"admin"`
+		WalletBalance        func(ctx context.Context, addr address.Address) (abi.TokenAmount, error)                                           `perm:"read"`
+		WalletBalanceAt      func(ctx context.Context, addr address.Address, tsk types.TipSetKey, excludePending bool) (abi.TokenAmount, error) `perm:"read"`
+		WalletDefaultAddress func(ctx context.Context) (address.Address, error)                                                                 `perm:"write"`
+		WalletDelete         func(ctx context.Context, addr address.Address) error                                                              `perm:"admin"`
+		WalletExport         func(ctx context.Context, addr address.Address, password string) (*types.KeyInfo, error)                           `perm:"admin"`
+		WalletHas            func(ctx context.Context, addr address.Address) (bool, error)                                                      `perm:"write"`
+		WalletImport         func(ctx context.Context, key *types.KeyInfo) (address.Address, error)                                             `perm:"admin"`
+		WalletNewAddress     func(ctx context.Context, protocol address.Protocol) (address.Address, error)                                      `perm:"write"`
+		WalletSetDefault     func(ctx context.Context, addr address.Address) error                                                              `perm:"write"`
+		WalletSign           func(ctx context.Context, k address.Address, msg []byte, meta types.MsgMeta) (*crypto.Signature, error)            `perm:"sign"`
+		WalletSignMessage    func(ctx context.Context, k address.Address, msg *types.Message) (*types.SignedMessage, error)                     `perm:"sign"`
+		WalletState          func(ctx context.Context) int                                                                                      `perm:"admin"`
 	}
 }
 
@@ -814,6 +883,9 @@ func (s *IWalletStruct) WalletAddresses(p0 context.Context) []address.Address {
 func (s *IWalletStruct) WalletBalance(p0 context.Context, p1 address.Address) (abi.TokenAmount, error) {
 	return s.Internal.WalletBalance(p0, p1)
 }
+func (s *IWalletStruct) WalletBalanceAt(p0 context.Context, p1 address.Address, p2 types.TipSetKey, p3 bool) (abi.TokenAmount, error) {
+	return s.Internal.WalletBalanceAt(p0, p1, p2, p3)
+}
 func (s *IWalletStruct) WalletDefaultAddress(p0 context.Context) (address.Address, error) {
 	return s.Internal.WalletDefaultAddress(p0)
 }
@@ -1062,6 +1134,28 @@ func (s *IActorEventStruct) SubscribeActorEventsRaw(p0 context.Context, p1 *type
 	return s.Internal.SubscribeActorEventsRaw(p0, p1)
 }
 
+type IAdminStruct struct {
+	Internal struct {
+		AdminCheckpoint func(ctx context.Context, key types.TipSetKey, confirm bool) error `perm:"admin"`
+		AdminDeleteObj  func(ctx context.Context, obj cid.Cid, confirm bool) error         `perm:"admin"`
+		AdminSetHead    func(ctx context.Context, key types.TipSetKey, confirm bool) error `perm:"admin"`
+		AdminShutdown   func(ctx context.Context, confirm bool) error                      `perm:"admin"`
+	}
+}
+
+func (s *IAdminStruct) AdminCheckpoint(p0 context.Context, p1 types.TipSetKey, p2 bool) error {
+	return s.Internal.AdminCheckpoint(p0, p1, p2)
+}
+func (s *IAdminStruct) AdminDeleteObj(p0 context.Context, p1 cid.Cid, p2 bool) error {
+	return s.Internal.AdminDeleteObj(p0, p1, p2)
+}
+func (s *IAdminStruct) AdminSetHead(p0 context.Context, p1 types.TipSetKey, p2 bool) error {
+	return s.Internal.AdminSetHead(p0, p1, p2)
+}
+func (s *IAdminStruct) AdminShutdown(p0 context.Context, p1 bool) error {
+	return s.Internal.AdminShutdown(p0, p1)
+}
+
 type FullNodeStruct struct {
 	IBlockStoreStruct
 	IChainStruct
@@ -1075,4 +1169,5 @@ type FullNodeStruct struct {
 	ICommonStruct
 	FullETHStruct
 	IActorEventStruct
+	IAdminStruct
 }
@@ -19,4 +19,10 @@ type ISyncer interface {
 	// SyncIncomingBlocks returns a channel streaming incoming, potentially not
 	// yet synced block headers.
 	SyncIncomingBlocks(ctx context.Context) (<-chan *types.BlockHeader, error) //perm:read
+	// SyncFailures returns the most recent sync targets that failed, oldest first, with the peer
+	// that announced them, the step that failed and the error.
+	SyncFailures(ctx context.Context) ([]types.SyncFailure, error) //perm:read
+	// SyncListPeerHeads returns the head last announced by each connected peer, heaviest first,
+	// with the difference of its height and parent weight to the head of the node.
+	SyncListPeerHeads(ctx context.Context) ([]types.PeerHead, error) //perm:read
 }
@@ -18,13 +18,17 @@ type IWallet interface {
 	WalletHas(ctx context.Context, addr address.Address) (bool, error)                                            //perm:write
 	WalletNewAddress(ctx context.Context, protocol address.Protocol) (address.Address, error)                     //perm:write
 	WalletBalance(ctx context.Context, addr address.Address) (abi.TokenAmount, error)                             //perm:read
-	WalletDefaultAddress(ctx context.Context) (address.Address, error)                                            //perm:write
-	WalletAddresses(ctx context.Context) []address.Address                                                        //perm:admin
-	WalletSetDefault(ctx context.Context, addr address.Address) error                                             //perm:write
-	WalletSignMessage(ctx context.Context, k address.Address, msg *types.Message) (*types.SignedMessage, error)   //perm:sign
-	LockWallet(ctx context.Context) error                                                                         //perm:admin
-	UnLockWallet(ctx context.Context, password []byte) error                                                      //perm:admin
-	SetPassword(ctx context.Context, password []byte) error                                                       //perm:admin
-	HasPassword(ctx context.Context) bool                                                                         //perm:admin
-	WalletState(ctx context.Context) int                                                                          //perm:admin
+	// WalletBalanceAt returns the balance of addr at the tipset. With excludePending, the value and maximum
+	// gas fees of the pending messages of addr are subtracted, giving what can be spent now, which is
+	// only supported at the head or with an empty tsk.
+	WalletBalanceAt(ctx context.Context, addr address.Address, tsk types.TipSetKey, excludePending bool) (abi.TokenAmount, error) //perm:read
+	WalletDefaultAddress(ctx context.Context) (address.Address, error)                                                            //perm:write
+	WalletAddresses(ctx context.Context) []address.Address                                                                        //perm:admin
+	WalletSetDefault(ctx context.Context, addr address.Address) error                                                             //perm:write
+	WalletSignMessage(ctx context.Context, k address.Address, msg *types.Message) (*types.SignedMessage, error)                   //perm:sign
+	LockWallet(ctx context.Context) error                                                                                         //perm:admin
+	UnLockWallet(ctx context.Context, password []byte) error                                                                      //perm:admin
+	SetPassword(ctx context.Context, password []byte) error                                                                       //perm:admin
+	HasPassword(ctx context.Context) bool                                                                                         //perm:admin
+	WalletState(ctx context.Context) int                                                                                          //perm:admin
 }
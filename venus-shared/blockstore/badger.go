@@ -92,6 +92,30 @@ func BadgerBlockstoreOptions(path string, readonly bool) (Options, error) {
 	return opts, nil
 }
 
+// compressedValueThreshold is the value threshold used when compression is enabled, values above
+// it go to the value log which badger never compresses.
+const compressedValueThreshold = 1 << 10
+
+// SetCompression compresses the tables with the named algorithm, "snappy" or "zstd", while
+// "none" or an empty name leaves them uncompressed. With compression the values up to 1KiB,
+// which covers most messages, receipts and headers, are kept in the tables so they get
+// compressed too. Tables written before keep their format until badger compacts them.
+func (opts *Options) SetCompression(name string) error {
+	switch name {
+	case "", "none":
+		opts.Compression = options.None
+		return nil
+	case "snappy":
+		opts.Compression = options.Snappy
+	case "zstd":
+		opts.Compression = options.ZSTD
+	default:
+		return fmt.Errorf("unknown compression %q, expected none, snappy or zstd", name)
+	}
+	opts.ValueThreshold = compressedValueThreshold
+	return nil
+}
+
 // badgerLogger is a local wrapper for go-log to make the interface
 // compatible with badger.Logger (namely, aliasing Warnf to Warningf)
 type badgerLogger struct {
@@ -0,0 +1,38 @@
+package blockstore
+
+import (
+	"context"
+	"testing"
+
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadgerCompression(t *testing.T) {
+	tf.UnitTest(t)
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	opts, err := BadgerBlockstoreOptions(dir, false)
+	require.NoError(t, err)
+	require.Error(t, opts.SetCompression("lz4"))
+	require.NoError(t, opts.SetCompression("zstd"))
+
+	bs, err := Open(opts)
+	require.NoError(t, err)
+	require.NoError(t, bs.PutMany(ctx, []blocks.Block{b0, b1}))
+	require.NoError(t, bs.Close())
+
+	// reopening without compression still reads the compressed tables
+	opts, err = BadgerBlockstoreOptions(dir, false)
+	require.NoError(t, err)
+	bs, err = Open(opts)
+	require.NoError(t, err)
+	defer bs.Close() // nolint: errcheck
+
+	blk, err := bs.Get(ctx, b1.Cid())
+	require.NoError(t, err)
+	require.Equal(t, b1.RawData(), blk.RawData())
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+)
+
+// AddressProtocolNames maps the user-facing protocol names accepted by the CLI and APIs to address protocols.
+var AddressProtocolNames = map[string]address.Protocol{
+	"id":        address.ID,
+	"secp256k1": address.SECP256K1,
+	"actor":     address.Actor,
+	"bls":       address.BLS,
+	"delegated": address.Delegated,
+}
+
+// ParseAddressProtocol returns the address protocol for a protocol name such as `bls` or `secp256k1`.
+func ParseAddressProtocol(name string) (address.Protocol, error) {
+	p, ok := AddressProtocolNames[strings.ToLower(name)]
+	if !ok {
+		return address.Unknown, fmt.Errorf("unrecognized address protocol %s", name)
+	}
+	return p, nil
+}
+
+// AddressProtocolName returns the user-facing name of an address protocol.
+func AddressProtocolName(p address.Protocol) string {
+	for name, protocol := range AddressProtocolNames {
+		if protocol == p {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// AddressNetwork returns the network encoded in the prefix of an address string.
+func AddressNetwork(addr string) (address.Network, error) {
+	if len(addr) == 0 {
+		return 0, address.ErrUnknownNetwork
+	}
+	switch addr[:1] {
+	case address.MainnetPrefix:
+		return address.Mainnet, nil
+	case address.TestnetPrefix:
+		return address.Testnet, nil
+	default:
+		return 0, address.ErrUnknownNetwork
+	}
+}
+
+// ParseAddressForNetwork parses an address string of any protocol, validating its checksum
+// and rejecting it when its prefix does not belong to the given network.
+func ParseAddressForNetwork(network address.Network, addr string) (address.Address, error) {
+	n, err := AddressNetwork(addr)
+	if err != nil {
+		return address.Undef, err
+	}
+	if n != network {
+		return address.Undef, fmt.Errorf("address %s does not belong to the %s network", addr, networkName(network))
+	}
+	return address.NewFromString(addr)
+}
+
+// ParseAddressForCurrentNetwork is ParseAddressForNetwork with the network the node is configured for.
+func ParseAddressForCurrentNetwork(addr string) (address.Address, error) {
+	return ParseAddressForNetwork(address.CurrentNetwork, addr)
+}
+
+// FormatAddress returns the checksummed string form of addr with the prefix of the given network.
+func FormatAddress(network address.Network, addr address.Address) (string, error) {
+	if addr == address.Undef {
+		return address.UndefAddressString, nil
+	}
+	var prefix string
+	switch network {
+	case address.Mainnet:
+		prefix = address.MainnetPrefix
+	case address.Testnet:
+		prefix = address.TestnetPrefix
+	default:
+		return address.UndefAddressString, address.ErrUnknownNetwork
+	}
+	// the payload and checksum encoding do not depend on the network, only the prefix does
+	return prefix + addr.String()[1:], nil
+}
+
+// NewIDAddressFromActorID returns the ID address of an actor.
+func NewIDAddressFromActorID(id abi.ActorID) address.Address {
+	addr, err := address.NewIDAddress(uint64(id))
+	if err != nil {
+		// NewIDAddress only fails for payloads that are not varint encodable, which never
+		// happens for a uint64.
+		panic(err)
+	}
+	return addr
+}
+
+// ActorIDFromAddress returns the actor id of an ID address.
+func ActorIDFromAddress(addr address.Address) (abi.ActorID, error) {
+	id, err := address.IDFromAddress(addr)
+	if err != nil {
+		return 0, err
+	}
+	return abi.ActorID(id), nil
+}
+
+// IsKeyAddress reports whether addr is derived from a public key (secp256k1 or bls).
+func IsKeyAddress(addr address.Address) bool {
+	return addr.Protocol() == address.SECP256K1 || addr.Protocol() == address.BLS
+}
+
+func networkName(n address.Network) string {
+	switch n {
+	case address.Mainnet:
+		return "main"
+	case address.Testnet:
+		return "test"
+	default:
+		return "unknown"
+	}
+}
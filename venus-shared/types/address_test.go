@@ -0,0 +1,96 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	tf "github.com/filecoin-project/venus/pkg/testhelpers/testflags"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAddressProtocol(t *testing.T) {
+	tf.UnitTest(t)
+
+	for name, p := range AddressProtocolNames {
+		got, err := ParseAddressProtocol(name)
+		require.NoError(t, err)
+		require.Equal(t, p, got)
+		require.Equal(t, name, AddressProtocolName(p))
+	}
+
+	got, err := ParseAddressProtocol("BLS")
+	require.NoError(t, err)
+	require.Equal(t, address.BLS, got)
+
+	_, err = ParseAddressProtocol("rsa")
+	require.Error(t, err)
+	require.Equal(t, "unknown", AddressProtocolName(address.Unknown))
+}
+
+func TestAddressNetworkPrefix(t *testing.T) {
+	tf.UnitTest(t)
+
+	idAddr := NewIDAddressFromActorID(1000)
+	blsAddr := MustParseAddress("f3vvmn62lofvhjd2ugzca6sof2j2ubwok6cj4xxbfzz4yuxfkgobpihhd2thlanmsh3w2ptld2gqkn2jvlss4a")
+	secpAddr := MustParseAddress("f1abjxfbp274xpdqcpuaykwkfb43omjotacm2p3za")
+	actorAddr, err := address.NewActorAddress([]byte("actor"))
+	require.NoError(t, err)
+	delegatedAddr, err := address.NewDelegatedAddress(10, []byte{1, 2, 3, 4})
+	require.NoError(t, err)
+
+	for _, addr := range []address.Address{idAddr, blsAddr, secpAddr, actorAddr, delegatedAddr} {
+		main, err := FormatAddress(address.Mainnet, addr)
+		require.NoError(t, err)
+		require.Equal(t, address.MainnetPrefix, main[:1])
+		test, err := FormatAddress(address.Testnet, addr)
+		require.NoError(t, err)
+		require.Equal(t, address.TestnetPrefix, test[:1])
+
+		n, err := AddressNetwork(main)
+		require.NoError(t, err)
+		require.Equal(t, address.Mainnet, n)
+
+		parsed, err := ParseAddressForNetwork(address.Mainnet, main)
+		require.NoError(t, err)
+		require.Equal(t, addr, parsed)
+		parsed, err = ParseAddressForNetwork(address.Testnet, test)
+		require.NoError(t, err)
+		require.Equal(t, addr, parsed)
+
+		_, err = ParseAddressForNetwork(address.Testnet, main)
+		require.Error(t, err)
+	}
+
+	_, err = FormatAddress(address.Network(3), idAddr)
+	require.ErrorIs(t, err, address.ErrUnknownNetwork)
+	_, err = AddressNetwork("x01000")
+	require.ErrorIs(t, err, address.ErrUnknownNetwork)
+}
+
+func TestAddressChecksum(t *testing.T) {
+	tf.UnitTest(t)
+
+	addr := "f1abjxfbp274xpdqcpuaykwkfb43omjotacm2p3za"
+	_, err := ParseAddressForNetwork(address.Mainnet, addr)
+	require.NoError(t, err)
+
+	// flip the last character of the checksum
+	_, err = ParseAddressForNetwork(address.Mainnet, addr[:len(addr)-1]+"b")
+	require.Error(t, err)
+}
+
+func TestActorIDFromAddress(t *testing.T) {
+	tf.UnitTest(t)
+
+	addr := NewIDAddressFromActorID(abi.ActorID(1234))
+	id, err := ActorIDFromAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, abi.ActorID(1234), id)
+
+	_, err = ActorIDFromAddress(MustParseAddress("f1abjxfbp274xpdqcpuaykwkfb43omjotacm2p3za"))
+	require.Error(t, err)
+
+	require.True(t, IsKeyAddress(MustParseAddress("f1abjxfbp274xpdqcpuaykwkfb43omjotacm2p3za")))
+	require.False(t, IsKeyAddress(addr))
+}
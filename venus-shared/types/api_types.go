@@ -64,6 +64,17 @@ type MessageCID struct {
 	Message *Message
 }
 
+// DecodedMessage is a message executed in a tipset with its receipt and decoded params.
+type DecodedMessage struct {
+	Cid     cid.Cid
+	Message *Message
+	Receipt MessageReceipt
+	// Params is Message.Params decoded for the method called, nil when there are none
+	Params interface{}
+	// DecodeError is why the params couldn't be decoded
+	DecodeError string `json:",omitempty"`
+}
+
 type ActorState struct {
 	Balance BigInt
 	Code    cid.Cid
@@ -172,9 +183,10 @@ type Deadline struct {
 var MarketBalanceNil = MarketBalance{}
 
 type MarketDealState struct {
-	SectorStartEpoch abi.ChainEpoch // -1 if not yet included in proven sector
-	LastUpdatedEpoch abi.ChainEpoch // -1 if deal state never updated
-	SlashEpoch       abi.ChainEpoch // -1 if deal never slashed
+	SectorStartEpoch abi.ChainEpoch   // -1 if not yet included in proven sector
+	LastUpdatedEpoch abi.ChainEpoch   // -1 if deal state never updated
+	SlashEpoch       abi.ChainEpoch   // -1 if deal never slashed
+	SectorNumber     abi.SectorNumber // 0 if not yet included in proven sector or before actors v13
 }
 
 func MakeDealState(mds market.DealState) MarketDealState {
@@ -182,6 +194,7 @@ func MakeDealState(mds market.DealState) MarketDealState {
 		SectorStartEpoch: mds.SectorStartEpoch(),
 		LastUpdatedEpoch: mds.LastUpdatedEpoch(),
 		SlashEpoch:       mds.SlashEpoch(),
+		SectorNumber:     mds.SectorNumber(),
 	}
 }
 
@@ -201,6 +214,10 @@ func (m mstate) SlashEpoch() abi.ChainEpoch {
 	return m.s.SlashEpoch
 }
 
+func (m mstate) SectorNumber() abi.SectorNumber {
+	return m.s.SectorNumber
+}
+
 func (m mstate) Equals(o market.DealState) bool {
 	return market.DealStatesEqual(m, o)
 }
@@ -209,17 +226,55 @@ func (m MarketDealState) Iface() market.DealState {
 	return mstate{m}
 }
 
+// DealSector is the sector a deal was activated in.
+type DealSector struct {
+	Miner        address.Address
+	SectorNumber abi.SectorNumber
+	SealedCID    cid.Cid
+}
+
 type MarketDeal struct {
 	Proposal DealProposal
 	State    MarketDealState
 }
 
+// MarketDealStateChange is the change of a deal's state between two tipsets, From is nil for
+// deals that were added and To is nil for deals that were removed.
+type MarketDealStateChange struct {
+	ID   abi.DealID
+	From *MarketDealState
+	To   *MarketDealState
+}
+
 type MinerPower struct {
 	MinerPower  power.Claim
 	TotalPower  power.Claim
 	HasMinPower bool
 }
 
+// SectorStatus is the on-chain status of a sector number of a miner.
+type SectorStatus struct {
+	// Allocated is true once the sector number was used, even if the sector is gone since
+	Allocated bool
+	// PreCommit is set while the sector is precommitted and not proven yet
+	PreCommit *SectorPreCommitOnChainInfo
+	// Info is set once the sector is proven and until it is terminated or expires
+	Info *SectorOnChainInfo
+	// Deadline and Partition locate the sector, they are only meaningful along Info
+	Deadline  uint64
+	Partition uint64
+}
+
+// ChainFsckIssue is a mismatch found by ChainFsck in the results of executing a tipset.
+type ChainFsckIssue struct {
+	Height abi.ChainEpoch
+	TipSet TipSetKey
+	// Problem describes the mismatch
+	Problem string
+	// Repaired is true when the stored results were replaced with the ones just computed
+	Repaired bool
+}
+
 type MinerSectors struct {
 	// Live sectors that should be proven.
 	Live uint64
@@ -247,6 +302,23 @@ type MsgLookup struct {
 	Height    abi.ChainEpoch
 }
 
+// MinerStats are the mining statistics of a miner, counted from Since to Through. Created and the
+// delays only cover the blocks created through this node.
+type MinerStats struct {
+	Miner        address.Address
+	Since        abi.ChainEpoch
+	Through      abi.ChainEpoch
+	ExpectedWins float64
+	Wins         uint64
+	// Orphaned are the blocks of the miner that were seen but didn't end in the chain
+	Orphaned uint64
+	Created  uint64
+	// AvgCreateDelay and MaxCreateDelay are the delays between the start of the epoch and the
+	// creation of the blocks
+	AvgCreateDelay time.Duration
+	MaxCreateDelay time.Duration
+}
+
 type MiningBaseInfo struct { //nolint
 	MinerPower        abi.StoragePower
 	NetworkPower      abi.StoragePower
@@ -493,3 +565,60 @@ type NodeChainStatus struct {
 	BlocksPerTipsetLast100      float64
 	BlocksPerTipsetLastFinality float64
 }
+
+// SnapshotInfo describes a chain snapshot exported by the node.
+type SnapshotInfo struct {
+	// Name is the file name of the snapshot in the snapshot directory of the node
+	Name   string
+	Height abi.ChainEpoch
+	Size   int64
+	Time   time.Time
+}
+
+// SnapshotStatus reports the state of the periodic chain snapshot export.
+type SnapshotStatus struct {
+	Enabled bool
+	// Running is true while a snapshot is being exported
+	Running    bool
+	NextHeight abi.ChainEpoch
+	// LastError is the error of the last failed export, empty once an export succeeds
+	LastError string
+	// Snapshots are the snapshots currently kept, oldest first
+	Snapshots []SnapshotInfo
+}
+
+// SyncFailure records a sync target the node failed to sync.
+type SyncFailure struct {
+	Time   time.Time
+	Sender peer.ID
+	Head   TipSetKey
+	Height abi.ChainEpoch
+	// Stage is the step that failed: headers, messages or execution, or target when the
+	// target was not synced at all
+	Stage string
+	// Reached is the height of the last tipset of the target that was applied, 0 if none was
+	Reached abi.ChainEpoch
+	Error   string
+}
+
+// PeerHead is the head last announced by a connected peer, compared with the head of the node.
+// Weights are parent weights, which are known without executing the heads.
+type PeerHead struct {
+	Peer   peer.ID
+	Head   TipSetKey
+	Height abi.ChainEpoch
+	Weight big.Int
+	// HeightDelta and WeightDelta are the height and weight of the head minus those of the node
+	HeightDelta abi.ChainEpoch
+	WeightDelta big.Int
+	Updated     time.Time
+}
+
+// CallWitness is the result of a call along with the state it read.
+type CallWitness struct {
+	Result *InvocResult
+	// StateRoot is the state the call was applied to
+	StateRoot cid.Cid
+	// Witness is a CAR, rooted at StateRoot, of the state tree nodes read by the call
+	Witness []byte
+}
@@ -1,6 +1,34 @@
 package types
 
+import (
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+)
+
 type BeaconEntry struct {
 	Round uint64
 	Data  []byte
 }
+
+// DrandStatus reports the health of the servers of a drand network.
+type DrandStatus struct {
+	// Start is the first epoch the network serves in the beacon schedule
+	Start     abi.ChainEpoch
+	ChainHash string
+	Endpoints []DrandEndpointStatus
+}
+
+// DrandEndpointStatus is the health of a drand server, a server is skipped until SkippedUntil
+// after a failure.
+type DrandEndpointStatus struct {
+	URL                 string
+	Healthy             bool
+	Successes           uint64
+	Failures            uint64
+	ConsecutiveFailures int
+	LastError           string
+	LastSuccess         time.Time
+	Latency             time.Duration
+	SkippedUntil        time.Time
+}
@@ -6,11 +6,14 @@ import (
 )
 
 var (
-	AttoFil  = types.AttoFil
-	FemtoFil = types.FemtoFil
-	NanoFil  = types.NanoFil
-	PicoFil  = types.PicoFil
-	ZeroFIL  = types.ZeroFIL
+	AttoFil        = types.AttoFil
+	ErrFILOverflow = types.ErrFILOverflow
+	ErrNegativeFIL = types.ErrNegativeFIL
+	FemtoFil       = types.FemtoFil
+	MaxFIL         = types.MaxFIL
+	NanoFil        = types.NanoFil
+	PicoFil        = types.PicoFil
+	ZeroFIL        = types.ZeroFIL
 )
 
 type (
@@ -18,7 +21,8 @@ type (
 )
 
 var (
-	FromFil      = types.FromFil
-	MustParseFIL = types.MustParseFIL
-	ParseFIL     = types.ParseFIL
+	FromFil             = types.FromFil
+	MustParseFIL        = types.MustParseFIL
+	ParseFIL            = types.ParseFIL
+	ParseNonNegativeFIL = types.ParseNonNegativeFIL
 )
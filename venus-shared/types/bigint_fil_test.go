@@ -61,7 +61,8 @@ func TestParseAttoFils(t *testing.T) {
 func TestInvalidFILString(t *testing.T) {
 	tf.UnitTest(t)
 	testValues := []string{
-		"0 nFIL", "1 nFIL", "1.001 nFIL", "100.10001 nFIL", "101100 nFIL", "5000.01 nFIL", "5000 nFIL",
+		"0 kFIL", "1 kFIL", "1.001 kFIL", "100.10001 kFIL", "101100 kFIL", "5000.01 kFIL", "5000 kFIL",
+		"1.0000000001 nFIL", "0.1 aFIL",
 		"1.001.1 FIL",
 		strings.Repeat("1", 51) + " FIL",
 	}
@@ -268,3 +269,68 @@ func TestTextUnMarshal(t *testing.T) {
 		require.Equal(t, a.Fil.String(), s.expect.String())
 	}
 }
+
+func TestParseFILDenominations(t *testing.T) {
+	tf.UnitTest(t)
+	for _, tc := range []struct {
+		in   string
+		atto string
+	}{
+		{"1.5 FIL", "1500000000000000000"},
+		{"1.5", "1500000000000000000"},
+		{"2 milliFIL", "2000000000000000"},
+		{"3 μFIL", "3000000000000"},
+		{"3 uFIL", "3000000000000"},
+		{"100 nanoFIL", "100000000000"},
+		{"100 nFIL", "100000000000"},
+		{"7 picoFIL", "7000000"},
+		{"7 femtoFIL", "7000"},
+		{"7 aFIL", "7"},
+		{"-1 FIL", "-1000000000000000000"},
+	} {
+		f, err := ParseFIL(tc.in)
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.atto, f.Int.String(), tc.in)
+	}
+
+	_, err := ParseFIL("1.5 nanoFIL")
+	require.NoError(t, err)
+	_, err = ParseFIL("1.5 femtoFIL")
+	require.NoError(t, err)
+	_, err = ParseFIL("1.5 aFIL")
+	require.Error(t, err)
+	_, err = ParseFIL("1 kiloFIL")
+	require.Error(t, err)
+}
+
+func TestFILSafeArithmetic(t *testing.T) {
+	tf.UnitTest(t)
+
+	_, err := ParseNonNegativeFIL("-1 aFIL")
+	require.ErrorIs(t, err, ErrNegativeFIL)
+	_, err = ParseNonNegativeFIL("2000000001 FIL")
+	require.ErrorIs(t, err, ErrFILOverflow)
+	max, err := ParseNonNegativeFIL("2000000000 FIL")
+	require.NoError(t, err)
+	require.True(t, BigInt(max).Equals(BigInt(MaxFIL)))
+
+	one := MustParseFIL("1 FIL")
+	two, err := one.CheckedAdd(one)
+	require.NoError(t, err)
+	require.Equal(t, "2 FIL", two.String())
+
+	_, err = max.CheckedAdd(MustParseFIL("1 aFIL"))
+	require.ErrorIs(t, err, ErrFILOverflow)
+
+	zero, err := one.CheckedSub(one)
+	require.NoError(t, err)
+	require.Equal(t, "0 FIL", zero.String())
+	_, err = one.CheckedSub(two)
+	require.ErrorIs(t, err, ErrNegativeFIL)
+
+	six, err := two.CheckedMul(3)
+	require.NoError(t, err)
+	require.Equal(t, "6 FIL", six.String())
+	_, err = max.CheckedMul(2)
+	require.ErrorIs(t, err, ErrFILOverflow)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/ipfs/go-cid"
 )
 
@@ -52,3 +53,35 @@ type MpoolUpdate struct {
 	Type    MpoolChange
 	Message *SignedMessage
 }
+
+// GasPremiumStats is the distribution of the gas premiums of a set of messages.
+type GasPremiumStats struct {
+	Messages int
+	Min      BigInt
+	P25      BigInt
+	P50      BigInt
+	P75      BigInt
+	P90      BigInt
+	Max      BigInt
+}
+
+// BaseFeeAt is the base fee the messages of the tipset at Height paid.
+type BaseFeeAt struct {
+	Height  abi.ChainEpoch
+	BaseFee BigInt
+}
+
+// GasMarket describes the gas premiums of the pending messages and of the messages included in
+// the last tipsets, along with the trend of the base fee, to give fee guidance to wallets.
+type GasMarket struct {
+	Height abi.ChainEpoch
+	// Pending are the messages in the pool
+	Pending GasPremiumStats
+	// Included are the messages of the last Tipsets tipsets
+	Included GasPremiumStats
+	Tipsets  int
+	// MinIncluded is the lowest premium of each of the last tipsets, oldest first
+	MinIncluded []BigInt
+	// BaseFees of the last tipsets and of the next one, oldest first
+	BaseFees []BaseFeeAt
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
 )
 
 type MpoolConfig struct {
@@ -13,4 +14,9 @@ type MpoolConfig struct {
 	ReplaceByFeeRatio      Percent
 	PruneCooldown          time.Duration
 	GasLimitOverestimation float64
+	// PendingTTL is the number of epochs the messages included in every tipset can outbid a pending
+	// message before it is dropped, 0 keeps messages until they are pruned
+	PendingTTL abi.ChainEpoch
+	// ExpireLocal applies PendingTTL to the messages of local and priority addresses too
+	ExpireLocal bool
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
 )
 
 type RawHost host.Host
@@ -25,7 +26,33 @@ type ConnMgrInfo struct {
 	Conns     map[string]time.Time
 }
 
+// NetAddrs are the addresses the host listens on, with the wildcard ones expanded to the
+// interfaces, and the ones it advertises to peers after the announce config is applied.
+type NetAddrs struct {
+	Listen    []multiaddr.Multiaddr
+	Announced []multiaddr.Multiaddr
+}
+
 type NatInfo struct {
 	Reachability network.Reachability
 	PublicAddrs  []string
 }
+
+// RelayStatus is the result of the last probe of a static relay.
+type RelayStatus struct {
+	ID        peer.ID
+	Reachable bool
+	RTT       time.Duration
+	// Advertised is whether the node advertises an address through the relay
+	Advertised bool
+	LastProbe  time.Time
+	Error      string
+}
+
+// ReachabilityInfo reports how other peers can dial the node.
+type ReachabilityInfo struct {
+	Reachability network.Reachability
+	// AdvertisedAddrs are the addresses the node announces to its peers
+	AdvertisedAddrs []string
+	Relays          []RelayStatus
+}
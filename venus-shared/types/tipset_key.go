@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"unicode"
 
 	"github.com/filecoin-project/go-state-types/abi"
 	block "github.com/ipfs/go-block-format"
@@ -150,6 +151,32 @@ func TipSetKeyFromBytes(encoded []byte) (TipSetKey, error) {
 	return TipSetKey{string(encoded)}, nil
 }
 
+// ParseTipSetKey parses a key from its String form, or from a comma or space separated list of
+// CIDs. The CIDs must be distinct and are kept in the given order, which must be the canonical
+// order of the blocks of the tipset. An empty string parses to EmptyTSK.
+func ParseTipSetKey(s string) (TipSetKey, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "{"), "}")
+	strs := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	cids := make([]cid.Cid, 0, len(strs))
+	seen := make(map[cid.Cid]struct{}, len(strs))
+	for _, str := range strs {
+		c, err := cid.Parse(str)
+		if err != nil {
+			return EmptyTSK, fmt.Errorf("invalid cid %s in tipset key: %w", str, err)
+		}
+		if _, ok := seen[c]; ok {
+			return EmptyTSK, fmt.Errorf("duplicate cid %s in tipset key", c)
+		}
+		seen[c] = struct{}{}
+		cids = append(cids, c)
+	}
+	return NewTipSetKey(cids...), nil
+}
+
 func (tsk TipSetKey) Cid() (cid.Cid, error) {
 	blk, err := tsk.ToStorageBlock()
 	if err != nil {
@@ -55,3 +55,32 @@ func TestTipSetKey(t *testing.T) {
 
 	require.Equal(t, tsk, decoded2)
 }
+
+func TestParseTipSetKey(t *testing.T) {
+	tf.UnitTest(t)
+	var cids []cid.Cid
+	testutil.Provide(t, &cids, testutil.WithSliceLen(3))
+	tsk := NewTipSetKey(cids...)
+
+	for _, s := range []string{
+		tsk.String(),
+		cids[0].String() + "," + cids[1].String() + "," + cids[2].String(),
+		" " + cids[0].String() + ", " + cids[1].String() + "  " + cids[2].String() + " ",
+	} {
+		parsed, err := ParseTipSetKey(s)
+		require.NoError(t, err, s)
+		require.True(t, tsk.Equals(parsed), s)
+	}
+
+	parsed, err := ParseTipSetKey("")
+	require.NoError(t, err)
+	require.True(t, parsed.IsEmpty())
+	parsed, err = ParseTipSetKey(EmptyTSK.String())
+	require.NoError(t, err)
+	require.True(t, parsed.IsEmpty())
+
+	_, err = ParseTipSetKey(cids[0].String() + "," + cids[0].String())
+	require.Error(t, err)
+	_, err = ParseTipSetKey("not a cid")
+	require.Error(t, err)
+}